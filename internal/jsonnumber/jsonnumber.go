@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package jsonnumber compares JSON numbers (RFC 8259) by their exact decimal value, for use by the custom types which
+// compare numbers regardless of their representation.
+package jsonnumber
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Decimal is the exact value of a JSON number, represented as digits × 10^exponent, where digits has no leading
+// or trailing zeros. Zero is represented by empty digits, a zero exponent and no sign, so that every representation of a
+// value (1, 1.0, 1e0, 10E-1) has the same Decimal. A big.Int exponent avoids imposing limits on numeric range.
+type Decimal struct {
+	negative bool
+	digits   string
+	exponent *big.Int
+}
+
+// ParseDecimal parses a JSON number (RFC 8259) into its exact decimal value, without any rounding.
+func ParseDecimal(s string) (Decimal, error) {
+	number := s
+	result := Decimal{
+		exponent: new(big.Int),
+	}
+
+	if strings.HasPrefix(number, "-") {
+		result.negative = true
+		number = number[1:]
+	}
+
+	if i := strings.IndexAny(number, "eE"); i != -1 {
+		if _, ok := result.exponent.SetString(strings.TrimPrefix(number[i+1:], "+"), 10); !ok {
+			return Decimal{}, fmt.Errorf("invalid JSON number %q", s)
+		}
+
+		number = number[:i]
+	}
+
+	integer, fraction, _ := strings.Cut(number, ".")
+
+	if integer == "" || !isDigits(integer) || !isDigits(fraction) {
+		return Decimal{}, fmt.Errorf("invalid JSON number %q", s)
+	}
+
+	digits := strings.TrimLeft(integer+fraction, "0")
+	trimmed := strings.TrimRight(digits, "0")
+
+	result.exponent.Sub(result.exponent, big.NewInt(int64(len(fraction))))
+	result.exponent.Add(result.exponent, big.NewInt(int64(len(digits)-len(trimmed))))
+	result.digits = trimmed
+
+	if result.digits == "" {
+		return Decimal{
+			exponent: new(big.Int),
+		}, nil
+	}
+
+	return result, nil
+}
+
+// Equal returns true if both numbers have the same exact value.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.negative == other.negative && d.digits == other.digits && d.exponent.Cmp(other.exponent) == 0
+}
+
+// String returns the number as a JSON number in scientific notation, such as "-15e-1", which is the same for every
+// representation of the value.
+func (d Decimal) String() string {
+	if d.digits == "" {
+		return "0"
+	}
+
+	sign := ""
+	if d.negative {
+		sign = "-"
+	}
+
+	return sign + d.digits + "e" + d.exponent.String()
+}
+
+// Equal returns true if both JSON numbers have the same exact decimal value.
+func Equal(a, b string) (bool, error) {
+	aNumber, err := ParseDecimal(a)
+	if err != nil {
+		return false, err
+	}
+
+	bNumber, err := ParseDecimal(b)
+	if err != nil {
+		return false, err
+	}
+
+	return aNumber.Equal(bNumber), nil
+}
+
+// Valid returns true if s is exactly a JSON number (RFC 8259), without surrounding whitespace.
+func Valid(s string) bool {
+	if s == "" || (s[0] != '-' && !isDigits(s[:1])) || !isDigits(s[len(s)-1:]) {
+		return false
+	}
+
+	// The first and last characters are not whitespace, so json.Valid will only accept a single number.
+	return json.Valid([]byte(s))
+}
+
+// isDigits returns true if s only contains ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,306 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package yamljson decodes YAML strings (YAML 1.2) into the Go values of their JSON equivalent, for use by the custom types
+// which accept YAML.
+package yamljson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/internal/jsonnumber"
+)
+
+var (
+	// yamlDecimalInteger, yamlOctalInteger, yamlHexInteger and yamlFloat match the numbers of the YAML 1.2 core schema,
+	// which unlike YAML 1.1 does not include integers with a leading zero as octal, binary integers or digit separators.
+	yamlDecimalInteger = regexp.MustCompile(`^[-+]?[0-9]+$`)
+	yamlOctalInteger   = regexp.MustCompile(`^0o[0-7]+$`)
+	yamlHexInteger     = regexp.MustCompile(`^0x[0-9a-fA-F]+$`)
+	yamlFloat          = regexp.MustCompile(`^([-+]?)(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+)
+
+// DecodeDocuments decodes every document in the YAML stream into JSON-compatible Go values: map[string]any, []any,
+// json.Number, string, bool or nil. As JSON (RFC 7159) is a subset of YAML 1.2, a JSON string decodes to the same values
+// as its YAML equivalent.
+//
+// Numbers are decoded from their text rather than through int or float64, so they keep their exact value. Each number is
+// converted to JSON syntax, such as "0.5" for ".5" and "16" for "0x10", but otherwise keeps its representation, so numbers
+// should be compared with Equal. Non-finite floating point numbers, which JSON cannot represent, are decoded as ".inf",
+// "-.inf" or ".nan" numbers so they can still be compared.
+func DecodeDocuments(s string) ([]any, error) {
+	dec := yaml.NewDecoder(strings.NewReader(s))
+	documents := make([]any, 0, 1)
+
+	for {
+		var node yaml.Node
+
+		err := dec.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The document is decoded by the YAML library first, which validates it, such as rejecting duplicate mapping keys
+		// and excessive aliasing, before it is converted from the node.
+		var document any
+
+		if err := node.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		jsonDocument, err := jsonCompatible(&node)
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, jsonDocument)
+	}
+}
+
+// Equal returns true if both decoded values are equal, where numbers are compared by their exact decimal value, so 1,
+// 1.0 and 1e0 are equal.
+func Equal(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for name, aMember := range a {
+			bMember, ok := b[name]
+			if !ok || !Equal(aMember, bMember) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !Equal(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+
+		if a == b {
+			return true
+		}
+
+		// Non-finite numbers are not valid JSON numbers, so they are only equal to the same representation.
+		equal, err := jsonnumber.Equal(a.String(), b.String())
+
+		return err == nil && equal
+	default:
+		return a == b
+	}
+}
+
+// jsonCompatible converts a YAML node into JSON-compatible Go values. Aliases are expanded and merge keys are applied
+// like the YAML library, where members of the mapping itself take precedence over merged members. Mapping keys are
+// converted to strings.
+func jsonCompatible(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+
+		return jsonCompatible(node.Content[0])
+	case yaml.AliasNode:
+		return jsonCompatible(node.Alias)
+	case yaml.MappingNode:
+		result := make(map[string]any, len(node.Content)/2)
+
+		var members []*yaml.Node
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			if key.Kind == yaml.ScalarNode && key.Value == "<<" && key.ShortTag() == "!!merge" {
+				if err := mergeMappings(result, value); err != nil {
+					return nil, err
+				}
+
+				continue
+			}
+
+			members = append(members, key, value)
+		}
+
+		for i := 0; i < len(members); i += 2 {
+			key, err := jsonCompatible(members[i])
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := jsonCompatible(members[i+1])
+			if err != nil {
+				return nil, err
+			}
+
+			switch key := key.(type) {
+			case string:
+				result[key] = value
+			case json.Number:
+				result[key.String()] = value
+			default:
+				result[fmt.Sprint(key)] = value
+			}
+		}
+
+		return result, nil
+	case yaml.SequenceNode:
+		result := make([]any, len(node.Content))
+
+		for i, element := range node.Content {
+			converted, err := jsonCompatible(element)
+			if err != nil {
+				return nil, err
+			}
+
+			result[i] = converted
+		}
+
+		return result, nil
+	case yaml.ScalarNode:
+		return scalarValue(node)
+	default:
+		return nil, fmt.Errorf("unsupported YAML node kind %d", node.Kind)
+	}
+}
+
+// mergeMappings adds the members of the mapping, or sequence of mappings, of a merge key to the result. Members of later
+// mappings in a sequence replace those of earlier mappings, like the YAML library.
+func mergeMappings(result map[string]any, node *yaml.Node) error {
+	sources := []*yaml.Node{node}
+
+	if node.Kind == yaml.SequenceNode {
+		sources = node.Content
+	}
+
+	for _, source := range sources {
+		merged, err := jsonCompatible(source)
+		if err != nil {
+			return err
+		}
+
+		mapping, ok := merged.(map[string]any)
+		if !ok {
+			return errors.New("map merge requires map or sequence of maps as the value")
+		}
+
+		for name, member := range mapping {
+			result[name] = member
+		}
+	}
+
+	return nil
+}
+
+// scalarValue converts a YAML scalar node into a JSON-compatible Go value. Untagged plain scalars are resolved as numbers
+// using the YAML 1.2 core schema, so "010" is the number 10 and "0b1" and "1_000" are strings, unlike in YAML 1.1.
+// Timestamps are kept as strings, as YAML 1.2 and JSON have no timestamp type.
+func scalarValue(node *yaml.Node) (any, error) {
+	tag := node.ShortTag()
+	untaggedPlain := node.Style&(yaml.TaggedStyle|yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0
+
+	if untaggedPlain || tag == "!!int" || tag == "!!float" {
+		if number, ok := yamlNumber(node.Value); ok {
+			return number, nil
+		}
+
+		if untaggedPlain && (tag == "!!int" || tag == "!!float") {
+			return node.Value, nil
+		}
+	}
+
+	if tag == "!!timestamp" {
+		return node.Value, nil
+	}
+
+	var value any
+
+	if err := node.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	switch value := value.(type) {
+	case int, int64, uint64, float64:
+		// Explicitly tagged numbers which are not valid YAML 1.2 numbers, such as "!!int 0b1", are converted by the YAML
+		// library. Decoded floating point numbers are finite, as non-finite numbers are valid YAML 1.2 numbers.
+		return json.Number(fmt.Sprint(value)), nil
+	case []byte:
+		// Binary values are compared by their content, as the base64 encoding of the same content can differ.
+		return string(value), nil
+	case nil, string, bool:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported YAML value type %T", value)
+	}
+}
+
+// yamlNumber converts a YAML 1.2 core schema number into a JSON number with the same exact value. The boolean result is
+// false if the scalar is not a number.
+func yamlNumber(s string) (json.Number, bool) {
+	switch s {
+	case ".inf", ".Inf", ".INF", "+.inf", "+.Inf", "+.INF":
+		return json.Number(".inf"), true
+	case "-.inf", "-.Inf", "-.INF":
+		return json.Number("-.inf"), true
+	case ".nan", ".NaN", ".NAN":
+		return json.Number(".nan"), true
+	}
+
+	switch {
+	case yamlOctalInteger.MatchString(s), yamlHexInteger.MatchString(s):
+		integer, ok := new(big.Int).SetString(s, 0)
+
+		return json.Number(integer.String()), ok
+	case yamlDecimalInteger.MatchString(s), yamlFloat.MatchString(s):
+		match := yamlFloat.FindStringSubmatch(s)
+		sign, mantissa, exponent := match[1], match[2], match[4]
+		integer, fraction, _ := strings.Cut(mantissa, ".")
+
+		integer = strings.TrimLeft(integer, "0")
+		if integer == "" {
+			integer = "0"
+		}
+
+		number := integer
+		if sign == "-" {
+			number = "-" + number
+		}
+
+		if fraction != "" {
+			number += "." + fraction
+		}
+
+		return json.Number(number + exponent), true
+	default:
+		return "", false
+	}
+}
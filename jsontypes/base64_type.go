@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*Base64Type)(nil)
+)
+
+// Base64Type is an attribute type that represents a valid base64 encoded (RFC 4648) JSON string (RFC 7159), using either the
+// standard or URL and filename safe alphabet, with or without padding. Semantic equality logic is defined for Base64Type such
+// that the decoded JSON strings are compared like NormalizedType, so differences in encoding and inconsequential differences
+// between the JSON strings (whitespace, property order, etc) are ignored.
+type Base64Type struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t Base64Type) String() string {
+	return "jsontypes.Base64Type"
+}
+
+// ValueType returns the Value type.
+func (t Base64Type) ValueType(ctx context.Context) attr.Value {
+	return Base64{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t Base64Type) Equal(o attr.Type) bool {
+	other, ok := o.(Base64Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t Base64Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Base64{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t Base64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
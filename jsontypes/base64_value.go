@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*Base64)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Base64)(nil)
+	_ xattr.ValidateableAttribute                = (*Base64)(nil)
+	_ function.ValidateableParameter             = (*Base64)(nil)
+)
+
+// Base64 represents a valid base64 encoded (RFC 4648) JSON string (RFC 7159), using either the standard or URL and filename
+// safe alphabet, with or without padding. Semantic equality logic is defined for Base64 such that the decoded JSON strings
+// are compared like Normalized. Use the DecodedJSON method to get the decoded JSON string, or NewBase64ValueFromJSON to
+// encode a JSON string.
+type Base64 struct {
+	basetypes.StringValue
+}
+
+// Type returns a Base64Type.
+func (v Base64) Type(_ context.Context) attr.Type {
+	return Base64Type{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Base64) Equal(o attr.Value) bool {
+	other, ok := o.(Base64)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given base64 encoded JSON string value is semantically equal to the current base64
+// encoded JSON string value. When compared, both values are decoded and the JSON strings are compared like Normalized. This
+// prevents differences in alphabet, padding or line breaks, along with inconsequential differences in the JSON strings
+// (whitespace, property order, etc), from causing Terraform data consistency errors and resource drift.
+func (v Base64) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Base64)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := base64JSONEqual(ctx, v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+func base64JSONEqual(ctx context.Context, s1, s2 string) (bool, error) {
+	s1, err := decodeBase64JSON(s1)
+	if err != nil {
+		return false, err
+	}
+
+	s2, err = decodeBase64JSON(s2)
+	if err != nil {
+		return false, err
+	}
+
+	return (&equalityRules{}).jsonStringsEqual(ctx, s1, s2)
+}
+
+// decodeBase64JSON decodes the base64 string, as with decodeBase64, and returns an error if the decoded string is not
+// valid JSON.
+func decodeBase64JSON(s string) (string, error) {
+	decoded, err := decodeBase64(s)
+	if err != nil {
+		return "", err
+	}
+
+	var raw json.RawMessage
+
+	if err := json.Unmarshal(decoded, &raw); err != nil {
+		return "", fmt.Errorf("decoded value is not valid JSON: %w", err)
+	}
+
+	return string(decoded), nil
+}
+
+// decodeBase64 decodes the base64 string, using the URL and filename safe alphabet if it contains either of its
+// characters. Line breaks are ignored and padding is optional.
+func decodeBase64(s string) ([]byte, error) {
+	encoding := base64.StdEncoding
+
+	if strings.ContainsAny(s, "-_") {
+		encoding = base64.URLEncoding
+	}
+
+	if !strings.Contains(s, "=") {
+		encoding = encoding.WithPadding(base64.NoPadding)
+	}
+
+	return encoding.DecodeString(s)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid base64 encoded (RFC 4648) JSON format (RFC 7159).
+func (v Base64) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := decodeBase64JSON(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Base64 JSON String Value",
+			"A string value was provided that is not valid base64 encoded (RFC 4648) JSON string format (RFC 7159).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid base64 encoded (RFC 4648) JSON format (RFC 7159).
+func (v Base64) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := decodeBase64JSON(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Base64 JSON String Value: "+
+				"A string value was provided that is not valid base64 encoded (RFC 4648) JSON string format (RFC 7159).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// DecodedJSON returns the decoded JSON string of the Base64 StringValue. A null, unknown or invalid value will produce an
+// error diagnostic.
+func (v Base64) DecodedJSON() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Base64 JSON Decode Error", "base64 string value is null"))
+		return "", diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Base64 JSON Decode Error", "base64 string value is unknown"))
+		return "", diags
+	}
+
+	jsonStr, err := decodeBase64JSON(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Base64 JSON Decode Error", err.Error()))
+		return "", diags
+	}
+
+	return jsonStr, diags
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the decoded JSON string of the Base64 StringValue and `target` input. A null
+// or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v Base64) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Base64 JSON Unmarshal Error", "base64 string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Base64 JSON Unmarshal Error", "base64 string value is unknown"))
+		return diags
+	}
+
+	jsonStr, err := decodeBase64JSON(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Base64 JSON Unmarshal Error", err.Error()))
+		return diags
+	}
+
+	err = json.Unmarshal([]byte(jsonStr), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Base64 JSON Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewBase64Null creates a Base64 with a null value. Determine whether the value is null via IsNull method.
+func NewBase64Null() Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewBase64Unknown creates a Base64 with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewBase64Unknown() Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewBase64Value creates a Base64 with a known value, which is expected to be base64 encoded. Access the value via
+// ValueString method.
+func NewBase64Value(value string) Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewBase64PointerValue creates a Base64 with a null value if nil or a known value, which is expected to be base64 encoded.
+// Access the value via ValueStringPointer method.
+func NewBase64PointerValue(value *string) Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
+
+// NewBase64ValueFromJSON creates a Base64 with a known value by encoding the given JSON string with the standard base64
+// alphabet and padding. The JSON string is not validated. Access the value via ValueString method, or the JSON string via
+// DecodedJSON method.
+func NewBase64ValueFromJSON(jsonStr string) Base64 {
+	return Base64{
+		StringValue: basetypes.NewStringValue(base64.StdEncoding.EncodeToString([]byte(jsonStr))),
+	}
+}
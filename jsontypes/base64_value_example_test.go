@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type Base64ResourceModel struct {
+	UserData jsontypes.Base64 `tfsdk:"user_data"`
+}
+
+type Base64Json struct {
+	Greeting string   `json:"greeting"`
+	Targets  []string `json:"targets"`
+}
+
+func ExampleBase64_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := Base64ResourceModel{
+		UserData: jsontypes.NewBase64Value("eyJncmVldGluZyI6ICJoZWxsbyIsICJ0YXJnZXRzIjogWyJ3b3JsZCIsICJtb29uIl19"),
+	}
+
+	// Check that the JSON data is known and able to be unmarshalled
+	if !data.UserData.IsNull() && !data.UserData.IsUnknown() {
+		var jsonStruct Base64Json
+
+		diags.Append(data.UserData.Unmarshal(&jsonStruct)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: {hello [world moon]}
+		fmt.Printf("%v\n", jsonStruct)
+	}
+}
+
+func ExampleNewBase64ValueFromJSON() {
+	// For example purposes, typically the value would be set in the data model for Plugin Framework to save into State.
+	userData := jsontypes.NewBase64ValueFromJSON(`{"greeting":"hello"}`)
+
+	// Output: eyJncmVldGluZyI6ImhlbGxvIn0=
+	fmt.Println(userData.ValueString())
+}
@@ -0,0 +1,409 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestBase64StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.Base64
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not equal - mismatched field values": {
+			// {"hello":"world"}
+			currentJson: jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			// {"hello":"dlrow"}
+			givenJson:     jsontypes.NewBase64Value("eyJoZWxsbyI6ImRscm93In0="),
+			expectedMatch: false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentJson:   jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			givenJson:     jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			expectedMatch: true,
+		},
+		"semantically equal - padding difference": {
+			currentJson:   jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			givenJson:     jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0"),
+			expectedMatch: true,
+		},
+		"semantically equal - line breaks": {
+			currentJson:   jsontypes.NewBase64Value("eyJoZWxsbyI6\r\nIndvcmxkIn0=\n"),
+			givenJson:     jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			expectedMatch: true,
+		},
+		"semantically equal - standard and url alphabets": {
+			// {"url": "https://example.com/?a=b&c=d~"}
+			currentJson: jsontypes.NewBase64Value("eyJ1cmwiOiAiaHR0cHM6Ly9leGFtcGxlLmNvbS8/YT1iJmM9ZH4ifQ=="),
+			givenJson:   jsontypes.NewBase64Value("eyJ1cmwiOiAiaHR0cHM6Ly9leGFtcGxlLmNvbS8_YT1iJmM9ZH4ifQ"),
+			// {"url":"https://example.com/?a=b&c=d~"}
+			expectedMatch: true,
+		},
+		"semantically equal - json whitespace and field order difference": {
+			// {"nums": [1, 2, 3], "hello": "world"}
+			currentJson: jsontypes.NewBase64Value("eyJudW1zIjogWzEsIDIsIDNdLCAiaGVsbG8iOiAid29ybGQifQ=="),
+			// {"hello":"world","nums":[1,2,3]}
+			givenJson:     jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIiwibnVtcyI6WzEsMiwzXX0="),
+			expectedMatch: true,
+		},
+		"semantically equal - constructed from json": {
+			currentJson:   jsontypes.NewBase64ValueFromJSON(`{"nums": [1, 2, 3], "hello": "world"}`),
+			givenJson:     jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIiwibnVtcyI6WzEsMiwzXX0"),
+			expectedMatch: true,
+		},
+		"error - invalid base64": {
+			currentJson:   jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			givenJson:     jsontypes.NewBase64Value("eyJoZWxsbyI6!ndvcmxkIn0="),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: illegal base64 data at input byte 12",
+				),
+			},
+		},
+		"error - invalid json": {
+			currentJson: jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			// notjson
+			givenJson:     jsontypes.NewBase64Value("bm90anNvbg=="),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: decoded value is not valid JSON: invalid character 'o' in literal null (expecting 'u')",
+				),
+			},
+		},
+		"error - not given base64 value": {
+			currentJson:   jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			givenJson:     basetypes.NewStringValue("eyJoZWxsbyI6IndvcmxkIn0="),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.Base64\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBase64ValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		base64        jsontypes.Base64
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			base64: jsontypes.Base64{},
+		},
+		"null": {
+			base64: jsontypes.NewBase64Null(),
+		},
+		"unknown": {
+			base64: jsontypes.NewBase64Unknown(),
+		},
+		"valid base64 json - standard alphabet": {
+			base64: jsontypes.NewBase64Value("eyJ1cmwiOiAiaHR0cHM6Ly9leGFtcGxlLmNvbS8/YT1iJmM9ZH4ifQ=="),
+		},
+		"valid base64 json - url alphabet without padding": {
+			base64: jsontypes.NewBase64Value("eyJ1cmwiOiAiaHR0cHM6Ly9leGFtcGxlLmNvbS8_YT1iJmM9ZH4ifQ"),
+		},
+		"invalid base64 - mixed alphabets": {
+			base64: jsontypes.NewBase64Value("eyJ1cmwiOiAiaHR0cHM6Ly9leGFtcGxlLmNvbS8/YT1iJmM9ZH4ifQ_-"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Base64 JSON String Value",
+					"A string value was provided that is not valid base64 encoded (RFC 4648) JSON string format (RFC 7159).\n\n"+
+						"Error: illegal base64 data at input byte 39\n"+
+						"Given Value: eyJ1cmwiOiAiaHR0cHM6Ly9leGFtcGxlLmNvbS8/YT1iJmM9ZH4ifQ_-\n",
+				),
+			},
+		},
+		"invalid base64 - plain json": {
+			base64: jsontypes.NewBase64Value(`{"hello":"world"}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Base64 JSON String Value",
+					"A string value was provided that is not valid base64 encoded (RFC 4648) JSON string format (RFC 7159).\n\n"+
+						"Error: illegal base64 data at input byte 0\n"+
+						"Given Value: {\"hello\":\"world\"}\n",
+				),
+			},
+		},
+		"invalid json - bracket mismatch": {
+			// {"hello":"world"
+			base64: jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIg=="),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Base64 JSON String Value",
+					"A string value was provided that is not valid base64 encoded (RFC 4648) JSON string format (RFC 7159).\n\n"+
+						"Error: decoded value is not valid JSON: unexpected end of JSON input\n"+
+						"Given Value: eyJoZWxsbyI6IndvcmxkIg==\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.base64.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBase64ValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		base64          jsontypes.Base64
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			base64: jsontypes.Base64{},
+		},
+		"null": {
+			base64: jsontypes.NewBase64Null(),
+		},
+		"unknown": {
+			base64: jsontypes.NewBase64Unknown(),
+		},
+		"valid base64 json": {
+			base64: jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+		},
+		"invalid json - normal string": {
+			// notjson
+			base64: jsontypes.NewBase64Value("bm90anNvbg=="),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Base64 JSON String Value: "+
+					"A string value was provided that is not valid base64 encoded (RFC 4648) JSON string format (RFC 7159).\n\n"+
+					"Error: decoded value is not valid JSON: invalid character 'o' in literal null (expecting 'u')\n"+
+					"Given Value: bm90anNvbg==\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.base64.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBase64DecodedJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		base64        jsontypes.Base64
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"base64 value is null": {
+			base64: jsontypes.NewBase64Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Base64 JSON Decode Error", "base64 string value is null"),
+			},
+		},
+		"base64 value is unknown": {
+			base64: jsontypes.NewBase64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Base64 JSON Decode Error", "base64 string value is unknown"),
+			},
+		},
+		"invalid base64": {
+			base64: jsontypes.NewBase64Value("not base64"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Base64 JSON Decode Error", "illegal base64 data at input byte 3"),
+			},
+		},
+		"valid base64 json": {
+			base64:   jsontypes.NewBase64Value("eyJ1cmwiOiAiaHR0cHM6Ly9leGFtcGxlLmNvbS8_YT1iJmM9ZH4ifQ"),
+			expected: `{"url": "https://example.com/?a=b&c=d~"}`,
+		},
+		"constructed from json": {
+			base64:   jsontypes.NewBase64ValueFromJSON(`{"hello": "world"}`),
+			expected: `{"hello": "world"}`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.base64.DecodedJSON()
+
+			if got != testCase.expected {
+				t.Errorf("Expected DecodedJSON to return: %q, but got: %q", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewBase64ValueFromJSON(t *testing.T) {
+	t.Parallel()
+
+	got := jsontypes.NewBase64ValueFromJSON(`{"hello":"world"}`)
+	expected := jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0=")
+
+	if !got.Equal(expected) {
+		t.Errorf("Expected %s, got %s", expected, got)
+	}
+}
+
+func TestBase64Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.Base64
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"base64 value is null ": {
+			json: jsontypes.NewBase64Null(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Base64 JSON Unmarshal Error",
+					"base64 string value is null",
+				),
+			},
+		},
+		"base64 value is unknown ": {
+			json: jsontypes.NewBase64Unknown(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Base64 JSON Unmarshal Error",
+					"base64 string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Base64 JSON Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Hello string \"json:\\\"hello\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			// {"hello": "world", "nums": [1, 2, 3], "test-bool": true}
+			json: jsontypes.NewBase64Value("eyJoZWxsbyI6ICJ3b3JsZCIsICJudW1zIjogWzEsIDIsIDNdLCAidGVzdC1ib29sIjogdHJ1ZX0="),
+			target: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{},
+			output: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{
+				Hello:   "world",
+				Numbers: []int{1, 2, 3},
+				Test:    true,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
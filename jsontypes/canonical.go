@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Canonicalize returns the canonical form of the given JSON string according to the JSON Canonicalization Scheme (JCS,
+// RFC 8785), which is suitable for hashing and signing. Whitespace is removed, object members are sorted by the UTF-16
+// code units of their names, numbers are formatted like ECMAScript and strings are escaped minimally.
+//
+// An error is returned if the string is not valid JSON or is not compatible with I-JSON (RFC 7493) as required by JCS,
+// such as if it contains duplicate object member names, lone surrogates or numbers which are out of the range of IEEE 754
+// double precision.
+func Canonicalize(jsonStr string) (string, error) {
+	value, err := decodeStrictJSON(jsonStr)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	if err := writeCanonicalJSON(&b, nil, value); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// writeCanonicalJSON writes the canonical form of the decoded value at the given location.
+func writeCanonicalJSON(b *strings.Builder, location []string, value any) error {
+	switch value := value.(type) {
+	case map[string]any:
+		b.WriteByte('{')
+
+		names := slices.SortedFunc(maps.Keys(value), compareUTF16)
+
+		for i, name := range names {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+
+			writeCanonicalString(b, name)
+			b.WriteByte(':')
+
+			if err := writeCanonicalJSON(b, childLocation(location, name), value[name]); err != nil {
+				return err
+			}
+		}
+
+		b.WriteByte('}')
+	case []any:
+		b.WriteByte('[')
+
+		for i, element := range value {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+
+			if err := writeCanonicalJSON(b, indexLocation(location, i), element); err != nil {
+				return err
+			}
+		}
+
+		b.WriteByte(']')
+	case json.Number:
+		number, err := formatECMAScriptNumber(value.String())
+		if err != nil {
+			return fmt.Errorf("at %q: %w", formatJSONPointer(location), err)
+		}
+
+		b.WriteString(number)
+	case string:
+		writeCanonicalString(b, value)
+	case bool:
+		b.WriteString(strconv.FormatBool(value))
+	default:
+		b.WriteString("null")
+	}
+
+	return nil
+}
+
+// compareUTF16 compares strings by their UTF-16 code units, as required for sorting object member names.
+func compareUTF16(a, b string) int {
+	return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+}
+
+// writeCanonicalString writes the string as a JSON string, only escaping the characters which must be escaped. Unlike
+// encoding/json, HTML characters and the U+2028 and U+2029 line terminators are not escaped.
+func writeCanonicalString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(b, `\u%04x`, r)
+
+				continue
+			}
+
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteByte('"')
+}
+
+// formatECMAScriptNumber formats the JSON number like the ECMAScript Number.prototype.toString method, after rounding it
+// to the nearest IEEE 754 double precision value.
+func formatECMAScriptNumber(number string) (string, error) {
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil || math.IsInf(f, 0) {
+		return "", fmt.Errorf("number %s is out of the range of IEEE 754 double precision", number)
+	}
+
+	if f == 0 {
+		// Negative zero is also formatted as 0.
+		return "0", nil
+	}
+
+	sign := ""
+
+	if f < 0 {
+		sign = "-"
+		f = -f
+	}
+
+	// The shortest decimal digits which round trip to the same value, such as "1.2345e+06", are the same digits chosen by
+	// ECMAScript. Only the placement of the decimal point and exponent differs.
+	mantissa, exponentStr, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	exponent, _ := strconv.Atoi(exponentStr)
+
+	// point is the position of the decimal point relative to the start of the digits.
+	point := exponent + 1
+
+	switch {
+	case len(digits) <= point && point <= 21:
+		return sign + digits + strings.Repeat("0", point-len(digits)), nil
+	case 0 < point && point <= 21:
+		return sign + digits[:point] + "." + digits[point:], nil
+	case -6 < point && point <= 0:
+		return sign + "0." + strings.Repeat("0", -point) + digits, nil
+	}
+
+	if len(digits) > 1 {
+		digits = digits[:1] + "." + digits[1:]
+	}
+
+	if exponent > 0 {
+		return sign + digits + "e+" + strconv.Itoa(exponent), nil
+	}
+
+	return sign + digits + "e" + strconv.Itoa(exponent), nil
+}
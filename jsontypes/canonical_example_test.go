@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func ExampleCanonicalize() {
+	canonical, err := jsontypes.Canonicalize(`{
+		"signer": "<notary@example.com>",
+		"amount": 4.50,
+		"currency": "\u20ac"
+	}`)
+	if err != nil {
+		return
+	}
+
+	fmt.Println(canonical)
+	fmt.Printf("%x\n", sha256.Sum256([]byte(canonical)))
+
+	// Output:
+	// {"amount":4.5,"currency":"€","signer":"<notary@example.com>"}
+	// b55a5539f27d0bd828c651d2df629708491ada4e06816d2400fd7189fb26a11f
+}
@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json        string
+		expected    string
+		expectedErr string
+	}{
+		// RFC 8785 Section 3.2.2
+		"rfc 8785 - sample": {
+			json: `{
+				"numbers": [333333333.33333329, 1E30, 4.50,
+				            2e-3, 0.000000000000000000000000001],
+				"string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+				"literals": [null, true, false]
+			}`,
+			expected: `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`,
+		},
+		// RFC 8785 Section 3.2.3
+		"rfc 8785 - sorting": {
+			json: `{
+				"\u20ac": "Euro Sign",
+				"\r": "Carriage Return",
+				"\ufb33": "Hebrew Letter Dalet With Dagesh",
+				"1": "One",
+				"\ud83d\ude00": "Emoji: Grinning Face",
+				"\u0080": "Control",
+				"\u00f6": "Latin Small Letter O With Diaeresis"
+			}`,
+			expected: "{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin Small Letter O With Diaeresis\"," +
+				"\"\u20ac\":\"Euro Sign\",\"\U0001f600\":\"Emoji: Grinning Face\",\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}",
+		},
+		"sorting - utf-16 code units rather than code points": {
+			json:     `{"\ufb33": 1, "\ud83d\ude00": 2, "\uffff": 3}`,
+			expected: "{\"\U0001f600\":2,\"\ufb33\":1,\"\uffff\":3}",
+		},
+		"sorting - nested objects": {
+			json:     `{"b": {"d": [{"f": 1, "e": 2}], "c": null}, "a": {}}`,
+			expected: `{"a":{},"b":{"c":null,"d":[{"e":2,"f":1}]}}`,
+		},
+		"strings - html and line terminators are not escaped": {
+			json:     `"<a href=\"x\">&amp;</a>\u2028\u2029"`,
+			expected: "\"<a href=\\\"x\\\">&amp;</a>\u2028\u2029\"",
+		},
+		"strings - control characters": {
+			json:     `"\u0000\u0008\u0009\u000a\u000c\u000d\u001f\u007f"`,
+			expected: "\"\\u0000\\b\\t\\n\\f\\r\\u001f\u007f\"",
+		},
+		"numbers - integers beyond double precision are rounded": {
+			json:     `[9007199254740993, 1.0, -0.0, 100E-2, 1e21, 123456789012345678901]`,
+			expected: `[9007199254740992,1,0,1,1e+21,123456789012345680000]`,
+		},
+		"error - invalid json": {
+			json:        `{"hello": "world"`,
+			expectedErr: "unexpected end of JSON input",
+		},
+		"error - duplicate object member name": {
+			json:        `{"a": {"b": 1, "b": 2}}`,
+			expectedErr: `at "/a/b": duplicate object member name "b"`,
+		},
+		"error - lone surrogate": {
+			json:        `{"a": ["\ud83d"]}`,
+			expectedErr: `at "/a/0": lone surrogate \ud83d`,
+		},
+		"error - lone surrogate in object member name": {
+			json:        `{"a": {"\ude00": 1}}`,
+			expectedErr: `at "/a": lone surrogate \ude00 in object member name`,
+		},
+		"error - invalid utf-8": {
+			json:        "{\"a\": \"\xff\"}",
+			expectedErr: `at "/a": invalid UTF-8 byte 0xff`,
+		},
+		"error - number out of range": {
+			json:        `{"a": [1e309]}`,
+			expectedErr: `at "/a/0": number 1e309 is out of the range of IEEE 754 double precision`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := jsontypes.Canonicalize(testCase.json)
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if got != testCase.expected {
+				t.Errorf("Expected Canonicalize to return: %s, but got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+// TestCanonicalizeNumbers uses the IEEE 754 double precision values and expected ECMAScript serializations of RFC 8785
+// Appendix B.
+func TestCanonicalizeNumbers(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[uint64]string{
+		0x0000000000000000: "0",
+		0x8000000000000000: "0",
+		0x0000000000000001: "5e-324",
+		0x8000000000000001: "-5e-324",
+		0x7fefffffffffffff: "1.7976931348623157e+308",
+		0xffefffffffffffff: "-1.7976931348623157e+308",
+		0x4340000000000000: "9007199254740992",
+		0xc340000000000000: "-9007199254740992",
+		0x4430000000000000: "295147905179352830000",
+		0x44b52d02c7e14af5: "9.999999999999997e+22",
+		0x44b52d02c7e14af6: "1e+23",
+		0x44b52d02c7e14af7: "1.0000000000000001e+23",
+		0x444b1ae4d6e2ef4e: "999999999999999700000",
+		0x444b1ae4d6e2ef4f: "999999999999999900000",
+		0x444b1ae4d6e2ef50: "1e+21",
+		0x3eb0c6f7a0b5ed8c: "9.999999999999997e-7",
+		0x3eb0c6f7a0b5ed8d: "0.000001",
+		0x41b3de4355555553: "333333333.3333332",
+		0x41b3de4355555554: "333333333.33333325",
+		0x41b3de4355555555: "333333333.3333333",
+		0x41b3de4355555556: "333333333.3333334",
+		0x41b3de4355555557: "333333333.33333343",
+		0xbecbf647612f3696: "-0.0000033333333333333333",
+		0x43143ff3c1cb0959: "1424953923781206.2",
+	}
+	for bits, expected := range testCases {
+
+		t.Run(strconv.FormatUint(bits, 16), func(t *testing.T) {
+			t.Parallel()
+
+			// The exact decimal representation is used as input, so the expected output depends only on the formatting.
+			number := strconv.FormatFloat(math.Float64frombits(bits), 'g', 800, 64)
+
+			got, err := jsontypes.Canonicalize(number)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if got != expected {
+				t.Errorf("Expected Canonicalize to return: %s, but got: %s", expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*CanonicalType)(nil)
+)
+
+// CanonicalType is an attribute type that represents a valid JSON string (RFC 7159) which can be canonicalized according to
+// the JSON Canonicalization Scheme (JCS, RFC 8785). Semantic equality logic is defined for CanonicalType such that JSON strings
+// with the same canonical form are equal, which ignores whitespace, property order, string escaping and number representation.
+type CanonicalType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t CanonicalType) String() string {
+	return "jsontypes.CanonicalType"
+}
+
+// ValueType returns the Value type.
+func (t CanonicalType) ValueType(ctx context.Context) attr.Value {
+	return Canonical{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t CanonicalType) Equal(o attr.Type) bool {
+	other, ok := o.(CanonicalType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t CanonicalType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Canonical{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t CanonicalType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*Canonical)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Canonical)(nil)
+	_ xattr.ValidateableAttribute                = (*Canonical)(nil)
+	_ function.ValidateableParameter             = (*Canonical)(nil)
+)
+
+// Canonical represents a valid JSON string (RFC 7159) which can be canonicalized according to the JSON Canonicalization
+// Scheme (JCS, RFC 8785). Semantic equality logic is defined for Canonical such that JSON strings with the same canonical
+// form are equal. Use the CanonicalJSON method to get the canonical form for hashing or signing.
+type Canonical struct {
+	basetypes.StringValue
+}
+
+// Type returns a CanonicalType.
+func (v Canonical) Type(_ context.Context) attr.Type {
+	return CanonicalType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Canonical) Equal(o attr.Value) bool {
+	other, ok := o.(Canonical)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given JSON string value is semantically equal to the current JSON string value.
+// When compared, both JSON strings are canonicalized according to RFC 8785 and the canonical forms must be byte-for-byte
+// equal. Unlike Normalized, numbers are compared after rounding to IEEE 754 double precision, so 1, 1.0 and 1e0 are equal.
+func (v Canonical) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Canonical)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := canonicalEqual(v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+func canonicalEqual(s1, s2 string) (bool, error) {
+	s1, err := Canonicalize(s1)
+	if err != nil {
+		return false, err
+	}
+
+	s2, err = Canonicalize(s2)
+	if err != nil {
+		return false, err
+	}
+
+	return s1 == s2, nil
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid JSON format (RFC 7159) which can be canonicalized (RFC 8785).
+func (v Canonical) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := Canonicalize(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Canonical JSON String Value",
+			"A string value was provided that is not valid JSON string format for canonicalization (RFC 8785).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid JSON format (RFC 7159) which can be canonicalized (RFC 8785).
+func (v Canonical) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := Canonicalize(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Canonical JSON String Value: "+
+				"A string value was provided that is not valid JSON string format for canonicalization (RFC 8785).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// CanonicalJSON returns the Canonical StringValue canonicalized according to RFC 8785, as with Canonicalize. A null, unknown
+// or invalid value will produce an error diagnostic.
+func (v Canonical) CanonicalJSON() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Canonical JSON Conversion Error", "json string value is null"))
+		return "", diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Canonical JSON Conversion Error", "json string value is unknown"))
+		return "", diags
+	}
+
+	jsonStr, err := Canonicalize(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Canonical JSON Conversion Error", err.Error()))
+		return "", diags
+	}
+
+	return jsonStr, diags
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the Canonical StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v Canonical) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Canonical JSON Unmarshal Error", "json string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Canonical JSON Unmarshal Error", "json string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Canonical JSON Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewCanonicalNull creates a Canonical with a null value. Determine whether the value is null via IsNull method.
+func NewCanonicalNull() Canonical {
+	return Canonical{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewCanonicalUnknown creates a Canonical with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewCanonicalUnknown() Canonical {
+	return Canonical{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewCanonicalValue creates a Canonical with a known value. Access the value via ValueString method.
+func NewCanonicalValue(value string) Canonical {
+	return Canonical{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewCanonicalPointerValue creates a Canonical with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewCanonicalPointerValue(value *string) Canonical {
+	return Canonical{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestCanonicalStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.Canonical
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not equal - mismatched field values": {
+			currentJson:   jsontypes.NewCanonicalValue(`{"hello": "dlrow", "nums": [3, 2, 1]}`),
+			givenJson:     jsontypes.NewCanonicalValue(`{"hello": "world", "nums": [1, 2, 3]}`),
+			expectedMatch: false,
+		},
+		"not equal - array item order difference": {
+			currentJson:   jsontypes.NewCanonicalValue(`[1, 2, 3]`),
+			givenJson:     jsontypes.NewCanonicalValue(`[3, 2, 1]`),
+			expectedMatch: false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentJson:   jsontypes.NewCanonicalValue(`{"hello":"world","nums":[1,2,3]}`),
+			givenJson:     jsontypes.NewCanonicalValue(`{"hello":"world","nums":[1,2,3]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - whitespace and field order difference": {
+			currentJson:   jsontypes.NewCanonicalValue("{\n  \"nums\": [1, 2, 3],\n  \"hello\": \"world\"\n}"),
+			givenJson:     jsontypes.NewCanonicalValue(`{"hello":"world","nums":[1,2,3]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - string escaping difference": {
+			currentJson:   jsontypes.NewCanonicalValue(`{"url": "\u003chttp:\/\/example.com\u003e", "euro": "\u20ac"}`),
+			givenJson:     jsontypes.NewCanonicalValue(`{"euro": "€", "url": "<http://example.com>"}`),
+			expectedMatch: true,
+		},
+		"semantically equal - number representation difference": {
+			currentJson:   jsontypes.NewCanonicalValue(`{"a": 1.0, "b": 1E30, "c": 0.000001, "d": -0}`),
+			givenJson:     jsontypes.NewCanonicalValue(`{"a": 1, "b": 1e+30, "c": 1e-6, "d": 0}`),
+			expectedMatch: true,
+		},
+		"error - duplicate object member name": {
+			currentJson:   jsontypes.NewCanonicalValue(`{"hello": "world"}`),
+			givenJson:     jsontypes.NewCanonicalValue(`{"hello": "world", "hello": "again"}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: at \"/hello\": duplicate object member name \"hello\"",
+				),
+			},
+		},
+		"error - not given canonical value": {
+			currentJson:   jsontypes.NewCanonicalValue(`{"hello": "world"}`),
+			givenJson:     basetypes.NewStringValue(`{"hello": "world"}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.Canonical\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCanonicalValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		canonical     jsontypes.Canonical
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			canonical: jsontypes.Canonical{},
+		},
+		"null": {
+			canonical: jsontypes.NewCanonicalNull(),
+		},
+		"unknown": {
+			canonical: jsontypes.NewCanonicalUnknown(),
+		},
+		"valid json object": {
+			canonical: jsontypes.NewCanonicalValue(`{"hello":"world", "array": [1, 2.5, 3e10]}`),
+		},
+		"invalid json - bracket mismatch": {
+			canonical: jsontypes.NewCanonicalValue(`{"hello":"world"`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Canonical JSON String Value",
+					"A string value was provided that is not valid JSON string format for canonicalization (RFC 8785).\n\n"+
+						"Error: unexpected end of JSON input\n"+
+						"Given Value: {\"hello\":\"world\"\n",
+				),
+			},
+		},
+		"invalid json - lone surrogate": {
+			canonical: jsontypes.NewCanonicalValue(`{"emoji":"\ud83d"}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Canonical JSON String Value",
+					"A string value was provided that is not valid JSON string format for canonicalization (RFC 8785).\n\n"+
+						"Error: at \"/emoji\": lone surrogate \\ud83d\n"+
+						"Given Value: {\"emoji\":\"\\ud83d\"}\n",
+				),
+			},
+		},
+		"invalid json - number out of range": {
+			canonical: jsontypes.NewCanonicalValue(`{"big":-1e400}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Canonical JSON String Value",
+					"A string value was provided that is not valid JSON string format for canonicalization (RFC 8785).\n\n"+
+						"Error: at \"/big\": number -1e400 is out of the range of IEEE 754 double precision\n"+
+						"Given Value: {\"big\":-1e400}\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.canonical.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCanonicalValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		canonical       jsontypes.Canonical
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			canonical: jsontypes.Canonical{},
+		},
+		"null": {
+			canonical: jsontypes.NewCanonicalNull(),
+		},
+		"unknown": {
+			canonical: jsontypes.NewCanonicalUnknown(),
+		},
+		"valid json object": {
+			canonical: jsontypes.NewCanonicalValue(`{"hello":"world", "array": [1, 2, 3]}`),
+		},
+		"invalid json - duplicate object member name": {
+			canonical: jsontypes.NewCanonicalValue(`{"a":1,"a":2}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Canonical JSON String Value: "+
+					"A string value was provided that is not valid JSON string format for canonicalization (RFC 8785).\n\n"+
+					"Error: at \"/a\": duplicate object member name \"a\"\n"+
+					"Given Value: {\"a\":1,\"a\":2}\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.canonical.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCanonicalCanonicalJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		canonical     jsontypes.Canonical
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"json value is null": {
+			canonical: jsontypes.NewCanonicalNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Canonical JSON Conversion Error", "json string value is null"),
+			},
+		},
+		"json value is unknown": {
+			canonical: jsontypes.NewCanonicalUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Canonical JSON Conversion Error", "json string value is unknown"),
+			},
+		},
+		"invalid json": {
+			canonical: jsontypes.NewCanonicalValue(`{"hello": }`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Canonical JSON Conversion Error", "invalid character '}' looking for beginning of value"),
+			},
+		},
+		"valid json": {
+			canonical: jsontypes.NewCanonicalValue(`{"url": "http://example.com/<path>", "amount": 4.50, "currency": "\u20ac"}`),
+			expected:  `{"amount":4.5,"currency":"€","url":"http://example.com/<path>"}`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.canonical.CanonicalJSON()
+
+			if got != testCase.expected {
+				t.Errorf("Expected CanonicalJSON to return: %q, but got: %q", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCanonicalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.Canonical
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"json value is null ": {
+			json: jsontypes.NewCanonicalNull(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Canonical JSON Unmarshal Error",
+					"json string value is null",
+				),
+			},
+		},
+		"json value is unknown ": {
+			json: jsontypes.NewCanonicalUnknown(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Canonical JSON Unmarshal Error",
+					"json string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewCanonicalValue(`{"hello": "world"}`),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Canonical JSON Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Hello string \"json:\\\"hello\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewCanonicalValue(`{"hello": "world", "nums": [1, 2, 3], "test-bool": true}`),
+			target: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{},
+			output: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{
+				Hello:   "world",
+				Numbers: []int{1, 2, 3},
+				Test:    true,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Comparator is an interface for provider-defined semantic equality rules, which can be registered for JSON Pointer paths
+// with the Comparators field of NormalizedOptions. This allows encoding API-specific rules, such as equivalent timestamp
+// formats or case-insensitive enumerations, while reusing the validation and other functionality of Normalized.
+//
+// Types with different Options pointers are only equal if their Comparators are equal. Comparators are compared with the
+// == operator when their values are comparable, which for structs with interface fields depends on the values held by
+// those fields, otherwise with reflect.DeepEqual. As reflect.DeepEqual never considers non-nil func values equal, func
+// types, such as adapters for ordinary functions, are only equal within types which share the same Options pointer, so
+// implementations should typically be comparable structs or pointers.
+type Comparator interface {
+	// Compare determines whether the prior and new values in the request are semantically equal.
+	Compare(ctx context.Context, req CompareRequest, resp *CompareResponse)
+}
+
+// CompareRequest represents a request to a Comparator to compare two decoded JSON values.
+type CompareRequest struct {
+	// Path is the JSON Pointer (RFC 6901) of the values in the prior JSON string, such as "/metadata/createdAt".
+	Path string
+
+	// PriorValue is the decoded value from the prior JSON string, after any options which remove or replace values, such
+	// as IgnorePaths, have been applied. It is one of map[string]any, []any, json.Number, string, bool or nil.
+	PriorValue any
+
+	// NewValue is the decoded value from the new JSON string, after any options which remove or replace values, such
+	// as IgnorePaths, have been applied. It is one of map[string]any, []any, json.Number, string, bool or nil.
+	NewValue any
+}
+
+// CompareResponse represents a response to a CompareRequest.
+type CompareResponse struct {
+	// Equal should be set to true if the values are semantically equal. Defaults to false.
+	Equal bool
+
+	// Diagnostics report errors or warnings related to comparing the values. An error diagnostic causes the JSON strings
+	// to be considered not equal, and is returned by StringSemanticEquals.
+	Diagnostics diag.Diagnostics
+}
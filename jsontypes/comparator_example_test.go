@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+// TimestampComparator considers RFC 3339 timestamps equal if they represent the same instant.
+type TimestampComparator struct{}
+
+func (c TimestampComparator) Compare(_ context.Context, req jsontypes.CompareRequest, resp *jsontypes.CompareResponse) {
+	priorString, priorOk := req.PriorValue.(string)
+	newString, newOk := req.NewValue.(string)
+
+	if !priorOk || !newOk {
+		resp.Equal = req.PriorValue == req.NewValue
+		return
+	}
+
+	priorTime, priorErr := time.Parse(time.RFC3339, priorString)
+	newTime, newErr := time.Parse(time.RFC3339, newString)
+
+	if priorErr != nil || newErr != nil {
+		resp.Equal = priorString == newString
+		return
+	}
+
+	resp.Equal = priorTime.Equal(newTime)
+}
+
+func ExampleComparator() {
+	ctx := context.Background()
+
+	// For example purposes, typically the type would be the CustomType of a schema attribute and the values would be
+	// created automatically by Plugin Framework.
+	typ := jsontypes.NormalizedType{
+		Options: &jsontypes.NormalizedOptions{
+			Comparators: map[string]jsontypes.Comparator{
+				"/schedule/*/start": TimestampComparator{},
+			},
+		},
+	}
+
+	priorValue, _ := typ.ValueFromString(ctx, basetypes.NewStringValue(`{"schedule": [{"start": "2024-01-01T10:00:00+02:00"}]}`))
+	newValue, _ := typ.ValueFromString(ctx, basetypes.NewStringValue(`{"schedule": [{"start": "2024-01-01T08:00:00Z"}]}`))
+
+	normalized, ok := priorValue.(jsontypes.Normalized)
+	if !ok {
+		return
+	}
+
+	match, diags := normalized.StringSemanticEquals(ctx, newValue)
+	if diags.HasError() {
+		return
+	}
+
+	// Output: true
+	fmt.Println(match)
+}
@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+)
+
+// defaultDashboardVolatilePaths are the JSON Pointers (RFC 6901) of the object members always ignored by Dashboard
+// values, in addition to any VolatileKeys configured by the DashboardType. Grafana assigns an "id" to dashboards and
+// panels, including the panels of collapsed rows and of the rows of the legacy dashboard schema, and increments the
+// "version" and "iteration" of a dashboard each time it is saved. Other "id" members, such as those of transformations
+// and field override matchers, are part of the dashboard configuration, so they are not ignored unless configured as
+// VolatileKeys.
+var defaultDashboardVolatilePaths = []jsonPointer{
+	{"id"},
+	{"iteration"},
+	{"version"},
+	{"panels", jsonPointerWildcard, "id"},
+	{"panels", jsonPointerWildcard, "panels", jsonPointerWildcard, "id"},
+	{"rows", jsonPointerWildcard, "panels", jsonPointerWildcard, "id"},
+}
+
+// validateDashboard returns an error if the JSON string is not a JSON object.
+func validateDashboard(jsonStr string) error {
+	value, err := decodeValidJSON(jsonStr)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := value.(map[string]any); !ok {
+		return fmt.Errorf("at \"\": expected a dashboard object, got %s", jsonTypeName(value))
+	}
+
+	return nil
+}
+
+// dashboardEqual returns true if both dashboard JSON strings are semantically equal, comparing them like Normalized with
+// the members at the default volatile paths, and object members named by any of the volatile keys at any depth, removed.
+func dashboardEqual(ctx context.Context, s1, s2 string, volatileKeys []string) (bool, error) {
+	r := &equalityRules{
+		ignorePaths:   defaultDashboardVolatilePaths,
+		ignoreMembers: volatileKeys,
+	}
+
+	return r.jsonStringsEqual(ctx, s1, s2)
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*DashboardType)(nil)
+)
+
+// DashboardType is an attribute type that represents a valid dashboard JSON string (RFC 7159), such as a Grafana dashboard
+// model, which is a JSON object. Semantic equality logic is defined for DashboardType such that volatile identifiers which
+// the API assigns or increments, such as the "id" and "version" of the dashboard and the "id" of each panel, are ignored
+// along with other inconsequential differences between JSON strings (whitespace, property order, etc), like NormalizedType.
+//
+// Options can be set to ignore additional object members. Types with different Options are not equal, while a nil Options
+// is equal to a pointer to the zero value.
+//
+// Values created by the NewDashboardValue and other NewDashboard functions have a DashboardType without Options. Use
+// the NewValue and other New methods of the type instead for values of a configured type, such as the elements of a
+// collection of that type, as every element must have the element type of the collection.
+type DashboardType struct {
+	basetypes.StringType
+
+	// Options configures the semantic equality logic of values of this type. A nil pointer keeps the default behavior.
+	// It is a pointer so the type remains comparable with the == operator.
+	Options *DashboardOptions
+}
+
+// DashboardOptions configures the semantic equality logic of Dashboard values.
+type DashboardOptions struct {
+	// VolatileKeys are the names of additional object members which are ignored at any depth when dashboards are
+	// compared, such as "uid". The "id" of the dashboard, its panels and the panels of its rows, and the "iteration" and
+	// "version" of the dashboard are always ignored, while other "id" members, such as those of transformations and field
+	// override matchers, are compared unless "id" is configured.
+	VolatileKeys []string
+}
+
+// volatileKeys returns the configured VolatileKeys, or nil if the options are nil.
+func (o *DashboardOptions) volatileKeys() []string {
+	if o == nil {
+		return nil
+	}
+
+	return o.VolatileKeys
+}
+
+// String returns a human readable string of the type name.
+func (t DashboardType) String() string {
+	if volatileKeys := t.Options.volatileKeys(); len(volatileKeys) > 0 {
+		return fmt.Sprintf("jsontypes.DashboardType[VolatileKeys: %q]", sortedStrings(volatileKeys))
+	}
+
+	return "jsontypes.DashboardType"
+}
+
+// ValueType returns the Value type.
+func (t DashboardType) ValueType(ctx context.Context) attr.Value {
+	return Dashboard{
+		options: t.Options,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DashboardType) Equal(o attr.Type) bool {
+	other, ok := o.(DashboardType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType) && stringSetsEqual(t.Options.volatileKeys(), other.Options.volatileKeys())
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t DashboardType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Dashboard{
+		StringValue: in,
+		options:     t.Options,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t DashboardType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// NewNull creates a Dashboard with a null value and the Options of the type. Determine whether the value is null via
+// IsNull method.
+func (t DashboardType) NewNull() Dashboard {
+	return Dashboard{
+		StringValue: basetypes.NewStringNull(),
+		options:     t.Options,
+	}
+}
+
+// NewUnknown creates a Dashboard with an unknown value and the Options of the type. Determine whether the value is
+// unknown via IsUnknown method.
+func (t DashboardType) NewUnknown() Dashboard {
+	return Dashboard{
+		StringValue: basetypes.NewStringUnknown(),
+		options:     t.Options,
+	}
+}
+
+// NewValue creates a Dashboard with a known value and the Options of the type. Access the value via ValueString method.
+func (t DashboardType) NewValue(value string) Dashboard {
+	return Dashboard{
+		StringValue: basetypes.NewStringValue(value),
+		options:     t.Options,
+	}
+}
+
+// NewPointerValue creates a Dashboard with a null value if nil or a known value, and the Options of the type. Access
+// the value via ValueStringPointer method.
+func (t DashboardType) NewPointerValue(value *string) Dashboard {
+	return Dashboard{
+		StringValue: basetypes.NewStringPointerValue(value),
+		options:     t.Options,
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestDashboardTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"true": {
+			in:          tftypes.NewValue(tftypes.String, `{"title":"Example","panels":[]}`),
+			expectation: jsontypes.NewDashboardValue(`{"title":"Example","panels":[]}`),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: jsontypes.NewDashboardUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.String, nil),
+			expectation: jsontypes.NewDashboardNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := jsontypes.DashboardType{}.ValueFromTerraform(ctx, testCase.in)
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if err == nil && testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if !got.Equal(testCase.expectation) {
+				t.Errorf("Expected %+v, got %+v", testCase.expectation, got)
+			}
+			if testCase.expectation.IsNull() != testCase.in.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", testCase.expectation.IsNull(), testCase.in.IsNull())
+			}
+			if testCase.expectation.IsUnknown() != !testCase.in.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", testCase.expectation.IsUnknown(), !testCase.in.IsKnown())
+			}
+		})
+	}
+}
+
+func TestDashboardTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      jsontypes.DashboardType
+		other    attr.Type
+		expected bool
+	}{
+		"equal - default volatile keys": {
+			typ:      jsontypes.DashboardType{},
+			other:    jsontypes.DashboardType{},
+			expected: true,
+		},
+		"equal - same volatile keys in different order": {
+			typ:      jsontypes.DashboardType{Options: &jsontypes.DashboardOptions{VolatileKeys: []string{"uid", "id"}}},
+			other:    jsontypes.DashboardType{Options: &jsontypes.DashboardOptions{VolatileKeys: []string{"id", "uid"}}},
+			expected: true,
+		},
+		"equal - nil and zero value options": {
+			typ:      jsontypes.DashboardType{},
+			other:    jsontypes.DashboardType{Options: &jsontypes.DashboardOptions{}},
+			expected: true,
+		},
+		"not equal - different volatile keys": {
+			typ:      jsontypes.DashboardType{Options: &jsontypes.DashboardOptions{VolatileKeys: []string{"id"}}},
+			other:    jsontypes.DashboardType{},
+			expected: false,
+		},
+		"not equal - different type": {
+			typ:      jsontypes.DashboardType{},
+			other:    jsontypes.NormalizedType{},
+			expected: false,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("Expected Equal to return: %t, but got: %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDashboardTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      jsontypes.DashboardType
+		expected string
+	}{
+		"default volatile keys": {
+			typ:      jsontypes.DashboardType{},
+			expected: "jsontypes.DashboardType",
+		},
+		"volatile keys": {
+			typ:      jsontypes.DashboardType{Options: &jsontypes.DashboardOptions{VolatileKeys: []string{"version", "id"}}},
+			expected: `jsontypes.DashboardType[VolatileKeys: ["id" "version"]]`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if got != testCase.expected {
+				t.Errorf("Expected String to return: %q, but got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDashboardTypeComparable(t *testing.T) {
+	t.Parallel()
+
+	options := &jsontypes.DashboardOptions{
+		VolatileKeys: []string{"uid"},
+	}
+
+	// Comparing interface values holding non-comparable types panics, so the types must remain comparable when configured.
+	var typ, other attr.Type = jsontypes.DashboardType{Options: options}, jsontypes.DashboardType{Options: options}
+
+	if typ != other {
+		t.Errorf("Expected %s to be comparable with the == operator", typ)
+	}
+
+	var value, otherValue attr.Value = typ.ValueType(context.Background()), other.ValueType(context.Background())
+
+	if value != otherValue {
+		t.Errorf("Expected %s values to be comparable with the == operator", typ)
+	}
+}
+
+func TestDashboardTypeNewValue(t *testing.T) {
+	t.Parallel()
+
+	typ := jsontypes.DashboardType{
+		Options: &jsontypes.DashboardOptions{VolatileKeys: []string{"uid"}},
+	}
+	value := `{"title":"example"}`
+
+	testCases := map[string]struct {
+		value           jsontypes.Dashboard
+		expectedNull    bool
+		expectedUnknown bool
+		expectedValue   *string
+	}{
+		"null": {
+			value:        typ.NewNull(),
+			expectedNull: true,
+		},
+		"unknown": {
+			value:           typ.NewUnknown(),
+			expectedUnknown: true,
+		},
+		"value": {
+			value:         typ.NewValue(value),
+			expectedValue: &value,
+		},
+		"pointer value": {
+			value:         typ.NewPointerValue(&value),
+			expectedValue: &value,
+		},
+		"pointer value - nil": {
+			value:        typ.NewPointerValue(nil),
+			expectedNull: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.value.Type(context.Background()); !got.Equal(typ) {
+				t.Errorf("Expected value type %s, got %s", typ, got)
+			}
+
+			if got := testCase.value.IsNull(); got != testCase.expectedNull {
+				t.Errorf("Expected IsNull %t, got %t", testCase.expectedNull, got)
+			}
+
+			if got := testCase.value.IsUnknown(); got != testCase.expectedUnknown {
+				t.Errorf("Expected IsUnknown %t, got %t", testCase.expectedUnknown, got)
+			}
+
+			var expectedString string
+			if testCase.expectedValue != nil {
+				expectedString = *testCase.expectedValue
+			}
+
+			if got := testCase.value.ValueString(); got != expectedString {
+				t.Errorf("Expected ValueString %q, got %q", expectedString, got)
+			}
+
+			// The pointer to an unknown value is not meaningful, so it is only checked for null and known values.
+			if !testCase.expectedUnknown {
+				got := testCase.value.ValueStringPointer()
+
+				switch {
+				case testCase.expectedValue == nil && got != nil:
+					t.Errorf("Expected nil ValueStringPointer, got %q", *got)
+				case testCase.expectedValue != nil && got == nil:
+					t.Errorf("Expected ValueStringPointer %q, got nil", *testCase.expectedValue)
+				case testCase.expectedValue != nil && *got != *testCase.expectedValue:
+					t.Errorf("Expected ValueStringPointer %q, got %q", *testCase.expectedValue, *got)
+				}
+			}
+
+			if _, diags := basetypes.NewListValue(typ, []attr.Value{testCase.value}); diags.HasError() {
+				t.Errorf("Unexpected diagnostics creating a list of the type: %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*Dashboard)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Dashboard)(nil)
+	_ xattr.ValidateableAttribute                = (*Dashboard)(nil)
+	_ function.ValidateableParameter             = (*Dashboard)(nil)
+)
+
+// Dashboard represents a valid dashboard JSON string (RFC 7159), such as a Grafana dashboard model, which is a JSON object.
+// Semantic equality logic is defined for Dashboard such that the identifiers an API assigns or increments each time the
+// dashboard is saved do not cause differences. Other inconsequential differences between JSON strings (whitespace, property
+// order, etc) are ignored, like Normalized.
+type Dashboard struct {
+	basetypes.StringValue
+
+	// options are the Options of the DashboardType that created this value.
+	options *DashboardOptions
+}
+
+// Type returns a DashboardType.
+func (v Dashboard) Type(_ context.Context) attr.Type {
+	return DashboardType{
+		Options: v.options,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Dashboard) Equal(o attr.Value) bool {
+	other, ok := o.(Dashboard)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given dashboard string value is semantically equal to the current dashboard
+// string value. When compared, the "id" of the dashboard, its panels and the panels of its rows, the "iteration" and
+// "version" of the dashboard, and object members named by the VolatileKeys of the type at any depth are removed, and the
+// dashboards are then compared like Normalized.
+func (v Dashboard) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Dashboard)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := dashboardEqual(ctx, v.ValueString(), newValue.ValueString(), v.options.volatileKeys())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid JSON object (RFC 7159).
+func (v Dashboard) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateDashboard(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Dashboard String Value",
+			"A string value was provided that is not valid dashboard JSON string format (RFC 7159).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid JSON object (RFC 7159).
+func (v Dashboard) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateDashboard(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Dashboard String Value: "+
+				"A string value was provided that is not valid dashboard JSON string format (RFC 7159).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the Dashboard StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v Dashboard) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Dashboard Unmarshal Error", "dashboard string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Dashboard Unmarshal Error", "dashboard string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Dashboard Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewDashboardNull creates a Dashboard with a null value. Determine whether the value is null via IsNull method.
+func NewDashboardNull() Dashboard {
+	return Dashboard{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewDashboardUnknown creates a Dashboard with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewDashboardUnknown() Dashboard {
+	return Dashboard{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewDashboardValue creates a Dashboard with a known value. Access the value via ValueString method.
+func NewDashboardValue(value string) Dashboard {
+	return Dashboard{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewDashboardPointerValue creates a Dashboard with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewDashboardPointerValue(value *string) Dashboard {
+	return Dashboard{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type DashboardResourceModel struct {
+	Dashboard jsontypes.Dashboard `tfsdk:"dashboard"`
+}
+
+type DashboardModel struct {
+	Title  string `json:"title"`
+	Panels []struct {
+		Title string `json:"title"`
+	} `json:"panels"`
+}
+
+func ExampleDashboard_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := DashboardResourceModel{
+		Dashboard: jsontypes.NewDashboardValue(`{"title": "Example", "panels": [{"title": "CPU"}]}`),
+	}
+
+	// Check that the dashboard data is known and able to be unmarshalled
+	if !data.Dashboard.IsNull() && !data.Dashboard.IsUnknown() {
+		var dashboard DashboardModel
+
+		diags.Append(data.Dashboard.Unmarshal(&dashboard)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: Example 1
+		fmt.Println(dashboard.Title, len(dashboard.Panels))
+	}
+}
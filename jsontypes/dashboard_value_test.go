@@ -0,0 +1,343 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestDashboardStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options       *jsontypes.DashboardOptions
+		currentJson   string
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentJson:   `{"title":"Example","panels":[{"type":"graph","title":"CPU"}]}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"title":"Example","panels":[{"type":"graph","title":"CPU"}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - json whitespace and field order difference": {
+			currentJson:   "{\n  \"panels\": [],\n  \"title\": \"Example\"\n}",
+			givenJson:     jsontypes.NewDashboardValue(`{"title":"Example","panels":[]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - default volatile keys": {
+			currentJson: `{"title":"Example","panels":[{"type":"graph","title":"CPU"},{"type":"row","panels":[{"type":"stat","title":"Memory"}]}]}`,
+			givenJson: jsontypes.NewDashboardValue(`{"id":42,"version":7,"iteration":1700000000000,"title":"Example",` +
+				`"panels":[{"id":1,"type":"graph","title":"CPU"},{"id":2,"type":"row","panels":[{"id":3,"type":"stat","title":"Memory"}]}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - volatile keys with different values": {
+			currentJson:   `{"id":1,"version":1,"title":"Example"}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"id":2,"version":2,"title":"Example"}`),
+			expectedMatch: true,
+		},
+		"semantically equal - configured volatile keys": {
+			options:       &jsontypes.DashboardOptions{VolatileKeys: []string{"uid", "updated"}},
+			currentJson:   `{"title":"Example","panels":[{"title":"CPU"}]}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"uid":"abc","updated":"2024-01-01T00:00:00Z","title":"Example","panels":[{"title":"CPU","uid":"def"}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - configured volatile keys in addition to defaults": {
+			options:       &jsontypes.DashboardOptions{VolatileKeys: []string{"uid"}},
+			currentJson:   `{"uid":"abc","id":1,"version":1,"iteration":1,"title":"Example","panels":[{"id":1,"title":"CPU"}]}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"uid":"def","id":2,"version":2,"iteration":2,"title":"Example","panels":[{"id":2,"title":"CPU"}]}`),
+			expectedMatch: true,
+		},
+		"not equal - configured volatile keys do not include transformation id": {
+			options:       &jsontypes.DashboardOptions{VolatileKeys: []string{"uid"}},
+			currentJson:   `{"title":"Example","panels":[{"transformations":[{"id":"organize","options":{}}]}]}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"title":"Example","panels":[{"transformations":[{"id":"merge","options":{}}]}]}`),
+			expectedMatch: false,
+		},
+		"semantically equal - default volatile keys of legacy rows": {
+			currentJson:   `{"title":"Example","rows":[{"title":"Row","panels":[{"type":"graph","title":"CPU"}]}]}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"title":"Example","rows":[{"title":"Row","panels":[{"id":1,"type":"graph","title":"CPU"}]}]}`),
+			expectedMatch: true,
+		},
+		"not equal - default volatile keys do not include transformation id": {
+			currentJson:   `{"title":"Example","panels":[{"id":1,"transformations":[{"id":"organize","options":{}}]}]}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"title":"Example","panels":[{"id":1,"transformations":[{"id":"merge","options":{}}]}]}`),
+			expectedMatch: false,
+		},
+		"not equal - default volatile keys do not include override matcher id": {
+			currentJson: `{"title":"Example","panels":[{"id":1,"fieldConfig":{"overrides":[{"matcher":{"id":"byName","options":"cpu"}}]}}]}`,
+			givenJson: jsontypes.NewDashboardValue(`{"title":"Example","panels":[{"id":2,` +
+				`"fieldConfig":{"overrides":[{"matcher":{"id":"byRegexp","options":"cpu"}}]}}]}`),
+			expectedMatch: false,
+		},
+		"not equal - different panel title": {
+			currentJson:   `{"title":"Example","panels":[{"id":1,"title":"CPU"}]}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"title":"Example","panels":[{"id":1,"title":"Memory"}]}`),
+			expectedMatch: false,
+		},
+		"not equal - panel order": {
+			currentJson:   `{"title":"Example","panels":[{"id":1,"title":"CPU"},{"id":2,"title":"Memory"}]}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"title":"Example","panels":[{"id":2,"title":"Memory"},{"id":1,"title":"CPU"}]}`),
+			expectedMatch: false,
+		},
+		"error - invalid json": {
+			currentJson:   `{"title":"Example"}`,
+			givenJson:     jsontypes.NewDashboardValue(`{"title":`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+		"error - not given dashboard value": {
+			currentJson:   `{"title":"Example"}`,
+			givenJson:     basetypes.NewStringValue(`{"title":"Example"}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.Dashboard\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typ := jsontypes.DashboardType{Options: testCase.options}
+
+			valuable, diags := typ.ValueFromString(context.Background(), basetypes.NewStringValue(testCase.currentJson))
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics creating value: %v", diags)
+			}
+
+			currentJson, ok := valuable.(jsontypes.Dashboard)
+			if !ok {
+				t.Fatalf("Expected jsontypes.Dashboard, got %T", valuable)
+			}
+
+			match, diags := currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDashboardValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dashboard     jsontypes.Dashboard
+		expectedError string
+	}{
+		"empty-struct": {
+			dashboard: jsontypes.Dashboard{},
+		},
+		"null": {
+			dashboard: jsontypes.NewDashboardNull(),
+		},
+		"unknown": {
+			dashboard: jsontypes.NewDashboardUnknown(),
+		},
+		"valid dashboard": {
+			dashboard: jsontypes.NewDashboardValue(`{"title":"Example","panels":[{"type":"graph"}]}`),
+		},
+		"invalid json": {
+			dashboard:     jsontypes.NewDashboardValue(`{"title":"Example"`),
+			expectedError: "unexpected end of JSON input",
+		},
+		"invalid dashboard - not an object": {
+			dashboard:     jsontypes.NewDashboardValue(`[{"title":"Example"}]`),
+			expectedError: `at "": expected a dashboard object, got array`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid Dashboard String Value",
+					"A string value was provided that is not valid dashboard JSON string format (RFC 7159).\n\n"+
+						"Error: "+testCase.expectedError+"\n"+
+						"Given Value: "+testCase.dashboard.ValueString()+"\n",
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.dashboard.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDashboardValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dashboard       jsontypes.Dashboard
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			dashboard: jsontypes.Dashboard{},
+		},
+		"null": {
+			dashboard: jsontypes.NewDashboardNull(),
+		},
+		"unknown": {
+			dashboard: jsontypes.NewDashboardUnknown(),
+		},
+		"valid dashboard": {
+			dashboard: jsontypes.NewDashboardValue(`{"title":"Example"}`),
+		},
+		"invalid dashboard - not an object": {
+			dashboard: jsontypes.NewDashboardValue(`"Example"`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Dashboard String Value: "+
+					"A string value was provided that is not valid dashboard JSON string format (RFC 7159).\n\n"+
+					"Error: at \"\": expected a dashboard object, got string\n"+
+					"Given Value: \"Example\"\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.dashboard.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDashboardUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.Dashboard
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"dashboard value is null ": {
+			json: jsontypes.NewDashboardNull(),
+			target: struct {
+				Title string `json:"title"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dashboard Unmarshal Error",
+					"dashboard string value is null",
+				),
+			},
+		},
+		"dashboard value is unknown ": {
+			json: jsontypes.NewDashboardUnknown(),
+			target: struct {
+				Title string `json:"title"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dashboard Unmarshal Error",
+					"dashboard string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewDashboardValue(`{"title":"Example"}`),
+			target: struct {
+				Title string `json:"title"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dashboard Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Title string \"json:\\\"title\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewDashboardValue(`{"id":42,"title":"Example"}`),
+			target: &struct {
+				ID    int    `json:"id"`
+				Title string `json:"title"`
+			}{},
+			output: &struct {
+				ID    int    `json:"id"`
+				Title string `json:"title"`
+			}{
+				ID:    42,
+				Title: "Example",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
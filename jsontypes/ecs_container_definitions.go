@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+)
+
+// ecsContainerDefinitionArrays are the members of a container definition which ECS returns as an empty array if they were
+// not configured.
+var ecsContainerDefinitionArrays = []string{"environment", "mountPoints", "portMappings", "volumesFrom"}
+
+// validateECSContainerDefinitions returns an error if the JSON string is not an array of container definitions, each of
+// which must be an object with a "name" and "image". The error describes the JSON Pointer (RFC 6901) of the offending
+// member.
+func validateECSContainerDefinitions(jsonStr string) error {
+	value, err := decodeValidJSON(jsonStr)
+	if err != nil {
+		return err
+	}
+
+	definitions, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("at \"\": expected an array of container definitions, got %s", jsonTypeName(value))
+	}
+
+	for i, definition := range definitions {
+		location := indexLocation(nil, i)
+
+		object, ok := definition.(map[string]any)
+		if !ok {
+			return fmt.Errorf("at %q: expected a container definition object, got %s", formatJSONPointer(location), jsonTypeName(definition))
+		}
+
+		for _, name := range []string{"name", "image"} {
+			member, ok := object[name]
+			if !ok {
+				return fmt.Errorf("at %q: missing required member %q", formatJSONPointer(location), name)
+			}
+
+			if _, ok := member.(string); !ok {
+				return fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(childLocation(location, name)), jsonTypeName(member))
+			}
+		}
+
+		if essential, ok := object["essential"]; ok && essential != nil {
+			if _, ok := essential.(bool); !ok {
+				return fmt.Errorf("at %q: expected a boolean, got %s", formatJSONPointer(childLocation(location, "essential")), jsonTypeName(essential))
+			}
+		}
+
+		for _, name := range ecsContainerDefinitionArrays {
+			if member, ok := object[name]; ok && member != nil {
+				if _, ok := member.([]any); !ok {
+					return fmt.Errorf("at %q: expected an array, got %s", formatJSONPointer(childLocation(location, name)), jsonTypeName(member))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ecsContainerDefinitionsEqual returns true if both container definitions JSON strings are semantically equal. Both are
+// normalized to apply the defaults ECS injects, then compared like Normalized with environment variables in any order.
+func ecsContainerDefinitionsEqual(ctx context.Context, s1, s2 string) (bool, error) {
+	priorValue, err := decodeJSON(s1)
+	if err != nil {
+		return false, err
+	}
+
+	newValue, err := decodeJSON(s2)
+	if err != nil {
+		return false, err
+	}
+
+	// ECS omits members with a null value, and returns environment variables sorted by name, so they are paired by name.
+	r := &equalityRules{
+		ignoreNullMembers: true,
+		arrayMergeKeys: []arrayMergeKey{
+			{path: jsonPointer{jsonPointerWildcard, "environment"}, member: "name"},
+		},
+	}
+
+	priorValue, _ = r.prepare(nil, priorValue)
+	newValue, _ = r.prepare(nil, newValue)
+
+	return r.valuesEqual(ctx, normalizeECSContainerDefinitions(priorValue), normalizeECSContainerDefinitions(newValue)), nil
+}
+
+// normalizeECSContainerDefinitions returns the prepared container definitions with the defaults which ECS injects applied.
+// A missing "essential" is set to true, empty "environment", "mountPoints", "portMappings" and "volumesFrom" arrays are
+// removed, and a port mapping without a "protocol" is set to "tcp". The prepared value is modified in place.
+func normalizeECSContainerDefinitions(value any) any {
+	definitions, ok := value.([]any)
+	if !ok {
+		return value
+	}
+
+	for _, definition := range definitions {
+		definition, ok := definition.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if _, ok := definition["essential"]; !ok {
+			definition["essential"] = true
+		}
+
+		for _, name := range ecsContainerDefinitionArrays {
+			if elements, ok := definition[name].([]any); ok && len(elements) == 0 {
+				delete(definition, name)
+			}
+		}
+
+		portMappings, ok := definition["portMappings"].([]any)
+		if !ok {
+			continue
+		}
+
+		for _, portMapping := range portMappings {
+			portMapping, ok := portMapping.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if _, ok := portMapping["protocol"]; !ok {
+				portMapping["protocol"] = "tcp"
+			}
+		}
+	}
+
+	return definitions
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*ECSContainerDefinitionsType)(nil)
+)
+
+// ECSContainerDefinitionsType is an attribute type that represents a valid Amazon ECS task definition container
+// definitions JSON string (RFC 7159), which is an array of container definition objects. Semantic equality logic is defined
+// for ECSContainerDefinitionsType such that the defaults ECS injects into the container definitions it returns are ignored
+// along with other inconsequential differences between JSON strings (whitespace, property order, etc), like NormalizedType.
+type ECSContainerDefinitionsType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t ECSContainerDefinitionsType) String() string {
+	return "jsontypes.ECSContainerDefinitionsType"
+}
+
+// ValueType returns the Value type.
+func (t ECSContainerDefinitionsType) ValueType(ctx context.Context) attr.Value {
+	return ECSContainerDefinitions{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t ECSContainerDefinitionsType) Equal(o attr.Type) bool {
+	other, ok := o.(ECSContainerDefinitionsType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t ECSContainerDefinitionsType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ECSContainerDefinitions{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t ECSContainerDefinitionsType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*ECSContainerDefinitions)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ECSContainerDefinitions)(nil)
+	_ xattr.ValidateableAttribute                = (*ECSContainerDefinitions)(nil)
+	_ function.ValidateableParameter             = (*ECSContainerDefinitions)(nil)
+)
+
+// ECSContainerDefinitions represents a valid Amazon ECS task definition container definitions JSON string, which is an array
+// of container definition objects with a "name" and "image". Semantic equality logic is defined for ECSContainerDefinitions
+// such that the defaults and ordering ECS applies to the container definitions it returns do not cause differences, while
+// the configured value is kept in state. Inconsequential differences between JSON strings (whitespace, property order,
+// etc) are also ignored, like Normalized.
+type ECSContainerDefinitions struct {
+	basetypes.StringValue
+}
+
+// Type returns an ECSContainerDefinitionsType.
+func (v ECSContainerDefinitions) Type(_ context.Context) attr.Type {
+	return ECSContainerDefinitionsType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v ECSContainerDefinitions) Equal(o attr.Value) bool {
+	other, ok := o.(ECSContainerDefinitions)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given container definitions string value is semantically equal to the current
+// container definitions string value. When compared, the container definitions are compared like Normalized after applying
+// the defaults ECS injects: a missing "essential" equals true, missing, null or empty "environment", "mountPoints",
+// "portMappings" and "volumesFrom" arrays are equal, and a port mapping without a "protocol" equals one with "tcp". Members
+// with a null value are ignored, and environment variables are paired by "name", so they may be in any order.
+func (v ECSContainerDefinitions) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ECSContainerDefinitions)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := ecsContainerDefinitionsEqual(ctx, v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid Amazon ECS container definitions JSON array.
+func (v ECSContainerDefinitions) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateECSContainerDefinitions(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid ECS Container Definitions String Value",
+			"A string value was provided that is not valid Amazon ECS container definitions string format.\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid Amazon ECS container definitions JSON array.
+func (v ECSContainerDefinitions) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateECSContainerDefinitions(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid ECS Container Definitions String Value: "+
+				"A string value was provided that is not valid Amazon ECS container definitions string format.\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the ECSContainerDefinitions StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v ECSContainerDefinitions) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("ECS Container Definitions Unmarshal Error", "ecs container definitions string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("ECS Container Definitions Unmarshal Error", "ecs container definitions string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("ECS Container Definitions Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewECSContainerDefinitionsNull creates an ECSContainerDefinitions with a null value. Determine whether the value is null via IsNull method.
+func NewECSContainerDefinitionsNull() ECSContainerDefinitions {
+	return ECSContainerDefinitions{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewECSContainerDefinitionsUnknown creates an ECSContainerDefinitions with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewECSContainerDefinitionsUnknown() ECSContainerDefinitions {
+	return ECSContainerDefinitions{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewECSContainerDefinitionsValue creates an ECSContainerDefinitions with a known value. Access the value via ValueString method.
+func NewECSContainerDefinitionsValue(value string) ECSContainerDefinitions {
+	return ECSContainerDefinitions{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewECSContainerDefinitionsPointerValue creates an ECSContainerDefinitions with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewECSContainerDefinitionsPointerValue(value *string) ECSContainerDefinitions {
+	return ECSContainerDefinitions{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
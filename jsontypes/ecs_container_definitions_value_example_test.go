@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type ECSTaskDefinitionResourceModel struct {
+	ContainerDefinitions jsontypes.ECSContainerDefinitions `tfsdk:"container_definitions"`
+}
+
+type ECSContainerDefinition struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+func ExampleECSContainerDefinitions_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := ECSTaskDefinitionResourceModel{
+		ContainerDefinitions: jsontypes.NewECSContainerDefinitionsValue(`[{"name": "app", "image": "nginx:latest", "essential": true}]`),
+	}
+
+	// Check that the container definitions data is known and able to be unmarshalled
+	if !data.ContainerDefinitions.IsNull() && !data.ContainerDefinitions.IsUnknown() {
+		var definitions []ECSContainerDefinition
+
+		diags.Append(data.ContainerDefinitions.Unmarshal(&definitions)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: app nginx:latest
+		fmt.Println(definitions[0].Name, definitions[0].Image)
+	}
+}
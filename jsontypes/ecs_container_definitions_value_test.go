@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestECSContainerDefinitionsStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.ECSContainerDefinitions
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","essential":true}]`),
+			givenJson:     jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","essential":true}]`),
+			expectedMatch: true,
+		},
+		"semantically equal - api defaults": {
+			currentJson: jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","portMappings":[{"containerPort":80}],` +
+				`"environment":[{"name":"B","value":"2"},{"name":"A","value":"1"}]}]`),
+			givenJson: jsontypes.NewECSContainerDefinitionsValue(`[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],` +
+				`"essential":true,"image":"nginx","mountPoints":[],"name":"app","portMappings":[{"containerPort":80,"protocol":"tcp"}],"volumesFrom":[]}]`),
+			expectedMatch: true,
+		},
+		"semantically equal - null and empty arrays": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","environment":null,"mountPoints":[],"command":null}]`),
+			givenJson:     jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","environment":[],"volumesFrom":[],"portMappings":[]}]`),
+			expectedMatch: true,
+		},
+		"not equal - container order": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx"},{"name":"sidecar","image":"envoy"}]`),
+			givenJson:     jsontypes.NewECSContainerDefinitionsValue(`[{"name":"sidecar","image":"envoy"},{"name":"app","image":"nginx"}]`),
+			expectedMatch: false,
+		},
+		"not equal - additional member": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx"}]`),
+			givenJson:     jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","cpu":256}]`),
+			expectedMatch: false,
+		},
+		"not equal - essential false": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx"}]`),
+			givenJson:     jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","essential":false}]`),
+			expectedMatch: false,
+		},
+		"not equal - udp protocol": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","portMappings":[{"containerPort":53}]}]`),
+			givenJson:     jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","portMappings":[{"containerPort":53,"protocol":"udp"}]}]`),
+			expectedMatch: false,
+		},
+		"not equal - different environment value": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}]}]`),
+			givenJson:     jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","environment":[{"name":"B","value":"1"},{"name":"A","value":"2"}]}]`),
+			expectedMatch: false,
+		},
+		"error - invalid json": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[]`),
+			givenJson:     jsontypes.NewECSContainerDefinitionsValue(`[{`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+		"error - not given ecs container definitions value": {
+			currentJson:   jsontypes.NewECSContainerDefinitionsValue(`[]`),
+			givenJson:     basetypes.NewStringValue(`[]`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.ECSContainerDefinitions\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestECSContainerDefinitionsValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definitions   jsontypes.ECSContainerDefinitions
+		expectedError string
+	}{
+		"empty-struct": {
+			definitions: jsontypes.ECSContainerDefinitions{},
+		},
+		"null": {
+			definitions: jsontypes.NewECSContainerDefinitionsNull(),
+		},
+		"unknown": {
+			definitions: jsontypes.NewECSContainerDefinitionsUnknown(),
+		},
+		"valid container definitions": {
+			definitions: jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","essential":true,"portMappings":[{"containerPort":80}],"mountPoints":null}]`),
+		},
+		"invalid json": {
+			definitions:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app"}`),
+			expectedError: "unexpected end of JSON input",
+		},
+		"invalid container definitions - not an array": {
+			definitions:   jsontypes.NewECSContainerDefinitionsValue(`{"name":"app","image":"nginx"}`),
+			expectedError: `at "": expected an array of container definitions, got object`,
+		},
+		"invalid container definition - not an object": {
+			definitions:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx"},"sidecar"]`),
+			expectedError: `at "/1": expected a container definition object, got string`,
+		},
+		"invalid container definition - missing image": {
+			definitions:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app"}]`),
+			expectedError: `at "/0": missing required member "image"`,
+		},
+		"invalid container definition - name not a string": {
+			definitions:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":1,"image":"nginx"}]`),
+			expectedError: `at "/0/name": expected a string, got number`,
+		},
+		"invalid container definition - essential not a boolean": {
+			definitions:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","essential":"true"}]`),
+			expectedError: `at "/0/essential": expected a boolean, got string`,
+		},
+		"invalid container definition - port mappings not an array": {
+			definitions:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx","portMappings":{"containerPort":80}}]`),
+			expectedError: `at "/0/portMappings": expected an array, got object`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid ECS Container Definitions String Value",
+					"A string value was provided that is not valid Amazon ECS container definitions string format.\n\n"+
+						"Error: "+testCase.expectedError+"\n"+
+						"Given Value: "+testCase.definitions.ValueString()+"\n",
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.definitions.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestECSContainerDefinitionsValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definitions     jsontypes.ECSContainerDefinitions
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			definitions: jsontypes.ECSContainerDefinitions{},
+		},
+		"null": {
+			definitions: jsontypes.NewECSContainerDefinitionsNull(),
+		},
+		"unknown": {
+			definitions: jsontypes.NewECSContainerDefinitionsUnknown(),
+		},
+		"valid container definitions": {
+			definitions: jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx"}]`),
+		},
+		"invalid container definition - missing name": {
+			definitions: jsontypes.NewECSContainerDefinitionsValue(`[{"image":"nginx"}]`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid ECS Container Definitions String Value: "+
+					"A string value was provided that is not valid Amazon ECS container definitions string format.\n\n"+
+					"Error: at \"/0\": missing required member \"name\"\n"+
+					"Given Value: [{\"image\":\"nginx\"}]\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.definitions.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestECSContainerDefinitionsUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.ECSContainerDefinitions
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"ecs container definitions value is null ": {
+			json:   jsontypes.NewECSContainerDefinitionsNull(),
+			target: []struct{}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ECS Container Definitions Unmarshal Error",
+					"ecs container definitions string value is null",
+				),
+			},
+		},
+		"ecs container definitions value is unknown ": {
+			json:   jsontypes.NewECSContainerDefinitionsUnknown(),
+			target: []struct{}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ECS Container Definitions Unmarshal Error",
+					"ecs container definitions string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json:   jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx"}]`),
+			target: []struct{}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"ECS Container Definitions Unmarshal Error",
+					"json: Unmarshal(non-pointer []struct {})",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewECSContainerDefinitionsValue(`[{"name":"app","image":"nginx"}]`),
+			target: &[]struct {
+				Name  string `json:"name"`
+				Image string `json:"image"`
+			}{},
+			output: &[]struct {
+				Name  string `json:"name"`
+				Image string `json:"image"`
+			}{
+				{
+					Name:  "app",
+					Image: "nginx",
+				},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
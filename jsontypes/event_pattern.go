@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// eventPatternOr is the member name of an object whose value is an array of alternative event patterns.
+const eventPatternOr = "$or"
+
+// eventPatternStringMatchers are the content filter matchers whose value is a string, or for "prefix" and "suffix", an
+// object with an "equals-ignore-case" string member.
+var eventPatternStringMatchers = []string{"prefix", "suffix", "equals-ignore-case", "wildcard", "cidr"}
+
+// eventPatternNumericOperators are the comparison operators of a "numeric" matcher.
+var eventPatternNumericOperators = []string{"=", "<", "<=", ">", ">="}
+
+// validateEventPattern returns an error if the JSON string is not a valid event pattern. Every member of the pattern must
+// be an object containing further members or a non-empty array of alternative values, which are strings, numbers,
+// booleans, null or content filter matcher objects such as {"prefix":"a"}, {"anything-but":["a","b"]},
+// {"numeric":[">",0,"<=",5]} or {"exists":true}. The error describes the JSON Pointer (RFC 6901) of the offending member.
+func validateEventPattern(jsonStr string) error {
+	value, err := decodeValidJSON(jsonStr)
+	if err != nil {
+		return err
+	}
+
+	return validateEventPatternObject(nil, value)
+}
+
+// validateEventPatternObject returns an error if the value at the given location is not a non-empty event pattern object.
+func validateEventPatternObject(location []string, value any) error {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("at %q: expected an event pattern object, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	if len(object) == 0 {
+		return fmt.Errorf("at %q: must not be empty", formatJSONPointer(location))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(object)) {
+		memberLocation := childLocation(location, name)
+
+		switch member := object[name].(type) {
+		case map[string]any:
+			if err := validateEventPatternObject(memberLocation, member); err != nil {
+				return err
+			}
+		case []any:
+			if len(member) == 0 {
+				return fmt.Errorf("at %q: must not be empty", formatJSONPointer(memberLocation))
+			}
+
+			for i, element := range member {
+				var err error
+
+				if name == eventPatternOr {
+					err = validateEventPatternObject(indexLocation(memberLocation, i), element)
+				} else {
+					err = validateEventPatternValue(indexLocation(memberLocation, i), element)
+				}
+
+				if err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("at %q: expected an object or array, got %s", formatJSONPointer(memberLocation), jsonTypeName(member))
+		}
+	}
+
+	return nil
+}
+
+// validateEventPatternValue returns an error if the value at the given location is not an alternative value of an event
+// pattern array, which is a scalar or a content filter matcher object with a single member.
+func validateEventPatternValue(location []string, value any) error {
+	switch value := value.(type) {
+	case string, json.Number, bool, nil:
+		return nil
+	case map[string]any:
+		if len(value) != 1 {
+			return fmt.Errorf("at %q: matcher must have exactly one member, got %d", formatJSONPointer(location), len(value))
+		}
+
+		for name, operand := range value {
+			return validateEventPatternMatcher(childLocation(location, name), name, operand)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("at %q: expected a string, number, boolean, null or matcher object, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+}
+
+// validateEventPatternMatcher returns an error if the operand at the given location is not valid for the named content
+// filter matcher.
+func validateEventPatternMatcher(location []string, name string, operand any) error {
+	switch {
+	case name == "exists":
+		if _, ok := operand.(bool); !ok {
+			return fmt.Errorf("at %q: expected a boolean, got %s", formatJSONPointer(location), jsonTypeName(operand))
+		}
+
+		return nil
+	case name == "numeric":
+		return validateEventPatternNumeric(location, operand)
+	case name == "anything-but":
+		return validateEventPatternAnythingBut(location, operand)
+	case name == "prefix" || name == "suffix":
+		if object, ok := operand.(map[string]any); ok {
+			return validateEventPatternStringMatcher(location, object, []string{"equals-ignore-case"})
+		}
+
+		fallthrough
+	case slices.Contains(eventPatternStringMatchers, name):
+		if _, ok := operand.(string); !ok {
+			return fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(location), jsonTypeName(operand))
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("at %q: unknown matcher %q", formatJSONPointer(location), name)
+	}
+}
+
+// validateEventPatternStringMatcher returns an error if the object at the given location does not have exactly one member,
+// which must be one of the given matchers with a string value.
+func validateEventPatternStringMatcher(location []string, object map[string]any, matchers []string) error {
+	if len(object) != 1 {
+		return fmt.Errorf("at %q: matcher must have exactly one member, got %d", formatJSONPointer(location), len(object))
+	}
+
+	for name, operand := range object {
+		if !slices.Contains(matchers, name) {
+			return fmt.Errorf("at %q: unknown matcher %q", formatJSONPointer(childLocation(location, name)), name)
+		}
+
+		if _, ok := operand.(string); !ok {
+			return fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(childLocation(location, name)), jsonTypeName(operand))
+		}
+	}
+
+	return nil
+}
+
+// validateEventPatternAnythingBut returns an error if the operand at the given location is not a valid "anything-but"
+// matcher operand, which is a string, a number, a non-empty array of strings or numbers, or an object with a single
+// "prefix", "suffix", "equals-ignore-case" or "wildcard" member.
+func validateEventPatternAnythingBut(location []string, operand any) error {
+	switch operand := operand.(type) {
+	case string, json.Number:
+		return nil
+	case []any:
+		if len(operand) == 0 {
+			return fmt.Errorf("at %q: must not be empty", formatJSONPointer(location))
+		}
+
+		for i, element := range operand {
+			switch element.(type) {
+			case string, json.Number:
+			default:
+				return fmt.Errorf("at %q: expected a string or number, got %s", formatJSONPointer(indexLocation(location, i)), jsonTypeName(element))
+			}
+		}
+
+		return nil
+	case map[string]any:
+		return validateEventPatternStringMatcher(location, operand, []string{"prefix", "suffix", "equals-ignore-case", "wildcard"})
+	default:
+		return fmt.Errorf("at %q: expected a string, number, array or object, got %s", formatJSONPointer(location), jsonTypeName(operand))
+	}
+}
+
+// validateEventPatternNumeric returns an error if the operand at the given location is not a valid "numeric" matcher
+// operand, which is an array of one comparison operator and number pair, or a lower bound pair followed by an upper bound
+// pair, such as [">", 0, "<=", 5].
+func validateEventPatternNumeric(location []string, operand any) error {
+	elements, ok := operand.([]any)
+	if !ok {
+		return fmt.Errorf("at %q: expected an array, got %s", formatJSONPointer(location), jsonTypeName(operand))
+	}
+
+	if len(elements) != 2 && len(elements) != 4 {
+		return fmt.Errorf("at %q: expected one or two operator and number pairs, got %d elements", formatJSONPointer(location), len(elements))
+	}
+
+	for i := 0; i < len(elements); i += 2 {
+		operator, ok := elements[i].(string)
+		if !ok {
+			return fmt.Errorf("at %q: expected a comparison operator, got %s", formatJSONPointer(indexLocation(location, i)), jsonTypeName(elements[i]))
+		}
+
+		if !slices.Contains(eventPatternNumericOperators, operator) {
+			return fmt.Errorf("at %q: unknown comparison operator %q", formatJSONPointer(indexLocation(location, i)), operator)
+		}
+
+		if _, ok := elements[i+1].(json.Number); !ok {
+			return fmt.Errorf("at %q: expected a number, got %s", formatJSONPointer(indexLocation(location, i+1)), jsonTypeName(elements[i+1]))
+		}
+	}
+
+	if len(elements) == 4 {
+		if lower := elements[0]; lower != ">" && lower != ">=" {
+			return fmt.Errorf("at %q: expected \">\" or \">=\" for the lower bound, got %q", formatJSONPointer(indexLocation(location, 0)), lower)
+		}
+
+		if upper := elements[2]; upper != "<" && upper != "<=" {
+			return fmt.Errorf("at %q: expected \"<\" or \"<=\" for the upper bound, got %q", formatJSONPointer(indexLocation(location, 2)), upper)
+		}
+	}
+
+	return nil
+}
+
+// eventPatternEqual returns true if both event pattern JSON strings are semantically equal. Both patterns are normalized
+// so the alternatives of every array are in the same order, then compared like Normalized.
+func eventPatternEqual(ctx context.Context, s1, s2 string) (bool, error) {
+	priorValue, err := decodeJSON(s1)
+	if err != nil {
+		return false, err
+	}
+
+	newValue, err := decodeJSON(s2)
+	if err != nil {
+		return false, err
+	}
+
+	return (&equalityRules{}).valuesEqual(ctx, normalizeEventPattern(priorValue), normalizeEventPattern(newValue)), nil
+}
+
+// normalizeEventPattern returns the decoded event pattern with the alternatives of every array sorted by their JSON
+// encoding, as an event matches a pattern array if it matches any of its values. This includes the arrays of "$or"
+// patterns and "anything-but" matchers, while the operator and number pairs of "numeric" matchers keep their order. The
+// decoded value is modified in place.
+func normalizeEventPattern(value any) any {
+	pattern, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+
+	for name, member := range pattern {
+		switch member := member.(type) {
+		case map[string]any:
+			pattern[name] = normalizeEventPattern(member)
+		case []any:
+			for i, element := range member {
+				if name == eventPatternOr {
+					member[i] = normalizeEventPattern(element)
+				} else if matcher, ok := element.(map[string]any); ok {
+					if anythingBut, ok := matcher["anything-but"].([]any); ok {
+						matcher["anything-but"] = sortedEventPatternAlternatives(anythingBut)
+					}
+				}
+			}
+
+			pattern[name] = sortedEventPatternAlternatives(member)
+		}
+	}
+
+	return pattern
+}
+
+// sortedEventPatternAlternatives returns the normalized alternatives sorted by their JSON encoding.
+func sortedEventPatternAlternatives(alternatives []any) []any {
+	type alternative struct {
+		encoded string
+		value   any
+	}
+
+	sorted := make([]alternative, 0, len(alternatives))
+
+	for _, value := range alternatives {
+		// The decoded value contains only JSON-compatible values, so encoding cannot fail. Object members are encoded in
+		// sorted order.
+		encoded, _ := json.Marshal(value)
+
+		sorted = append(sorted, alternative{encoded: string(encoded), value: value})
+	}
+
+	slices.SortStableFunc(sorted, func(a, b alternative) int {
+		return strings.Compare(a.encoded, b.encoded)
+	})
+
+	for i, alternative := range sorted {
+		alternatives[i] = alternative.value
+	}
+
+	return alternatives
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*EventPatternType)(nil)
+)
+
+// EventPatternType is an attribute type that represents a valid event pattern JSON string (RFC 7159), such as an Amazon
+// EventBridge rule event pattern, following the event pattern grammar. Semantic equality logic is defined for
+// EventPatternType such that the order of alternative values in pattern arrays is ignored along with other inconsequential
+// differences between JSON strings (whitespace, property order, etc), like NormalizedType.
+type EventPatternType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t EventPatternType) String() string {
+	return "jsontypes.EventPatternType"
+}
+
+// ValueType returns the Value type.
+func (t EventPatternType) ValueType(ctx context.Context) attr.Value {
+	return EventPattern{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t EventPatternType) Equal(o attr.Type) bool {
+	other, ok := o.(EventPatternType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t EventPatternType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return EventPattern{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t EventPatternType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*EventPattern)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*EventPattern)(nil)
+	_ xattr.ValidateableAttribute                = (*EventPattern)(nil)
+	_ function.ValidateableParameter             = (*EventPattern)(nil)
+)
+
+// EventPattern represents a valid event pattern JSON string, such as an Amazon EventBridge rule event pattern, in which
+// every field to match is an array of alternative values or content filter matchers, such as "prefix", "anything-but",
+// "numeric" and "exists". Semantic equality logic is defined for EventPattern such that alternatives in a different order
+// do not cause differences, along with other inconsequential differences between JSON strings (whitespace, property
+// order, etc), like Normalized.
+type EventPattern struct {
+	basetypes.StringValue
+}
+
+// Type returns an EventPatternType.
+func (v EventPattern) Type(_ context.Context) attr.Type {
+	return EventPatternType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v EventPattern) Equal(o attr.Value) bool {
+	other, ok := o.(EventPattern)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given event pattern string value is semantically equal to the current event
+// pattern string value. When compared, the alternatives of every pattern array are sorted, as an event matches the array if
+// it matches any of its values, and the patterns are then compared like Normalized. The patterns of "$or" arrays and the
+// values of "anything-but" arrays are sorted in the same way, while the operator and number pairs of "numeric" matchers
+// are compared in order.
+func (v EventPattern) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(EventPattern)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := eventPatternEqual(ctx, v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid event pattern.
+func (v EventPattern) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateEventPattern(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Event Pattern String Value",
+			"A string value was provided that is not valid event pattern string format.\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid event pattern.
+func (v EventPattern) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateEventPattern(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Event Pattern String Value: "+
+				"A string value was provided that is not valid event pattern string format.\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the EventPattern StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v EventPattern) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Event Pattern Unmarshal Error", "event pattern string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Event Pattern Unmarshal Error", "event pattern string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Event Pattern Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewEventPatternNull creates an EventPattern with a null value. Determine whether the value is null via IsNull method.
+func NewEventPatternNull() EventPattern {
+	return EventPattern{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewEventPatternUnknown creates an EventPattern with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewEventPatternUnknown() EventPattern {
+	return EventPattern{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewEventPatternValue creates an EventPattern with a known value. Access the value via ValueString method.
+func NewEventPatternValue(value string) EventPattern {
+	return EventPattern{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewEventPatternPointerValue creates an EventPattern with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewEventPatternPointerValue(value *string) EventPattern {
+	return EventPattern{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type EventRuleResourceModel struct {
+	EventPattern jsontypes.EventPattern `tfsdk:"event_pattern"`
+}
+
+type EventPatternSources struct {
+	Source []string `json:"source"`
+}
+
+func ExampleEventPattern_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := EventRuleResourceModel{
+		EventPattern: jsontypes.NewEventPatternValue(`{"source": ["aws.ec2", "aws.s3"], "detail": {"state": [{"anything-but": "pending"}]}}`),
+	}
+
+	// Check that the event pattern data is known and able to be unmarshalled
+	if !data.EventPattern.IsNull() && !data.EventPattern.IsUnknown() {
+		var pattern EventPatternSources
+
+		diags.Append(data.EventPattern.Unmarshal(&pattern)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: [aws.ec2 aws.s3]
+		fmt.Println(pattern.Source)
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestEventPatternStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.EventPattern
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"source":["aws.ec2"],"detail-type":["EC2 Instance State-change Notification"]}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"source":["aws.ec2"],"detail-type":["EC2 Instance State-change Notification"]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - json whitespace and field order difference": {
+			currentJson:   jsontypes.NewEventPatternValue("{\n  \"detail-type\": [\"Scheduled Event\"],\n  \"source\": [\"aws.events\"]\n}"),
+			givenJson:     jsontypes.NewEventPatternValue(`{"source":["aws.events"],"detail-type":["Scheduled Event"]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - alternative order": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"source":["a","b"],"detail":{"state":["running",null,1,true]}}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"source":["b","a"],"detail":{"state":[true,1,null,"running"]}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - matcher order": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"detail":{"bucket":[{"prefix":"logs-"},{"exists":false},"data"]}}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"detail":{"bucket":["data",{"exists":false},{"prefix":"logs-"}]}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - anything-but order": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"detail":{"state":[{"anything-but":["stopped","pending"]}]}}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"detail":{"state":[{"anything-but":["pending","stopped"]}]}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - or pattern order": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"$or":[{"source":["b","a"]},{"detail-type":["x"]}]}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"$or":[{"detail-type":["x"]},{"source":["a","b"]}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - event field named numeric": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"detail":{"numeric":["a","b"]}}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"detail":{"numeric":["b","a"]}}`),
+			expectedMatch: true,
+		},
+		"not equal - different alternatives": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"source":["a","b"]}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"source":["a","c"]}`),
+			expectedMatch: false,
+		},
+		"not equal - numeric operator order": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"detail":{"count":[{"numeric":[">",0,"<=",5]}]}}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"detail":{"count":[{"numeric":["<=",5,">",0]}]}}`),
+			expectedMatch: false,
+		},
+		"not equal - matcher moved between fields": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"source":["a"],"detail-type":["b"]}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"source":["b"],"detail-type":["a"]}`),
+			expectedMatch: false,
+		},
+		"error - invalid json": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"source":["a"]}`),
+			givenJson:     jsontypes.NewEventPatternValue(`{"source":[`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+		"error - not given event pattern value": {
+			currentJson:   jsontypes.NewEventPatternValue(`{"source":["a"]}`),
+			givenJson:     basetypes.NewStringValue(`{"source":["a"]}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.EventPattern\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEventPatternValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern       jsontypes.EventPattern
+		expectedError string
+	}{
+		"empty-struct": {
+			pattern: jsontypes.EventPattern{},
+		},
+		"null": {
+			pattern: jsontypes.NewEventPatternNull(),
+		},
+		"unknown": {
+			pattern: jsontypes.NewEventPatternUnknown(),
+		},
+		"valid pattern": {
+			pattern: jsontypes.NewEventPatternValue(`{"source":["aws.ec2"],"detail":{"state":["running","stopped"],"instance-id":[{"exists":true}]}}`),
+		},
+		"valid pattern - matchers": {
+			pattern: jsontypes.NewEventPatternValue(`{"detail":{` +
+				`"a":[{"prefix":"logs-"},{"prefix":{"equals-ignore-case":"LOGS-"}},{"suffix":".png"}],` +
+				`"b":[{"anything-but":"x"},{"anything-but":1},{"anything-but":["x","y"]},{"anything-but":{"prefix":"tmp-"}}],` +
+				`"c":[{"numeric":["=",3]},{"numeric":[">",0,"<=",5]}],` +
+				`"d":[{"equals-ignore-case":"Alice"},{"wildcard":"*.png"},{"cidr":"10.0.0.0/24"}],` +
+				`"e":[null,true,1.5]}}`),
+		},
+		"valid pattern - or": {
+			pattern: jsontypes.NewEventPatternValue(`{"source":["aws.ec2"],"$or":[{"detail-type":["a"]},{"detail":{"state":["b"]}}]}`),
+		},
+		"invalid json": {
+			pattern:       jsontypes.NewEventPatternValue(`{"source":`),
+			expectedError: "unexpected end of JSON input",
+		},
+		"invalid pattern - not an object": {
+			pattern:       jsontypes.NewEventPatternValue(`["aws.ec2"]`),
+			expectedError: `at "": expected an event pattern object, got array`,
+		},
+		"invalid pattern - empty": {
+			pattern:       jsontypes.NewEventPatternValue(`{}`),
+			expectedError: `at "": must not be empty`,
+		},
+		"invalid pattern - scalar field": {
+			pattern:       jsontypes.NewEventPatternValue(`{"source":"aws.ec2"}`),
+			expectedError: `at "/source": expected an object or array, got string`,
+		},
+		"invalid pattern - empty array": {
+			pattern:       jsontypes.NewEventPatternValue(`{"detail":{"state":[]}}`),
+			expectedError: `at "/detail/state": must not be empty`,
+		},
+		"invalid pattern - nested array": {
+			pattern:       jsontypes.NewEventPatternValue(`{"source":[["aws.ec2"]]}`),
+			expectedError: `at "/source/0": expected a string, number, boolean, null or matcher object, got array`,
+		},
+		"invalid pattern - or not patterns": {
+			pattern:       jsontypes.NewEventPatternValue(`{"$or":[{"source":["a"]},"b"]}`),
+			expectedError: `at "/$or/1": expected an event pattern object, got string`,
+		},
+		"invalid matcher - multiple members": {
+			pattern:       jsontypes.NewEventPatternValue(`{"source":[{"prefix":"a","suffix":"b"}]}`),
+			expectedError: `at "/source/0": matcher must have exactly one member, got 2`,
+		},
+		"invalid matcher - unknown": {
+			pattern:       jsontypes.NewEventPatternValue(`{"source":[{"startsWith":"a"}]}`),
+			expectedError: `at "/source/0/startsWith": unknown matcher "startsWith"`,
+		},
+		"invalid matcher - prefix not a string": {
+			pattern:       jsontypes.NewEventPatternValue(`{"source":[{"prefix":1}]}`),
+			expectedError: `at "/source/0/prefix": expected a string, got number`,
+		},
+		"invalid matcher - prefix unknown option": {
+			pattern:       jsontypes.NewEventPatternValue(`{"source":[{"prefix":{"wildcard":"a*"}}]}`),
+			expectedError: `at "/source/0/prefix/wildcard": unknown matcher "wildcard"`,
+		},
+		"invalid matcher - exists not a boolean": {
+			pattern:       jsontypes.NewEventPatternValue(`{"detail":{"id":[{"exists":"true"}]}}`),
+			expectedError: `at "/detail/id/0/exists": expected a boolean, got string`,
+		},
+		"invalid matcher - anything-but boolean": {
+			pattern:       jsontypes.NewEventPatternValue(`{"detail":{"id":[{"anything-but":false}]}}`),
+			expectedError: `at "/detail/id/0/anything-but": expected a string, number, array or object, got boolean`,
+		},
+		"invalid matcher - anything-but array element": {
+			pattern:       jsontypes.NewEventPatternValue(`{"detail":{"id":[{"anything-but":["a",null]}]}}`),
+			expectedError: `at "/detail/id/0/anything-but/1": expected a string or number, got null`,
+		},
+		"invalid matcher - numeric length": {
+			pattern:       jsontypes.NewEventPatternValue(`{"detail":{"count":[{"numeric":[">",0,"<"]}]}}`),
+			expectedError: `at "/detail/count/0/numeric": expected one or two operator and number pairs, got 3 elements`,
+		},
+		"invalid matcher - numeric operator": {
+			pattern:       jsontypes.NewEventPatternValue(`{"detail":{"count":[{"numeric":["!=",0]}]}}`),
+			expectedError: `at "/detail/count/0/numeric/0": unknown comparison operator "!="`,
+		},
+		"invalid matcher - numeric operand": {
+			pattern:       jsontypes.NewEventPatternValue(`{"detail":{"count":[{"numeric":[">","0"]}]}}`),
+			expectedError: `at "/detail/count/0/numeric/1": expected a number, got string`,
+		},
+		"invalid matcher - numeric range bounds": {
+			pattern:       jsontypes.NewEventPatternValue(`{"detail":{"count":[{"numeric":["<",5,">",0]}]}}`),
+			expectedError: `at "/detail/count/0/numeric/0": expected ">" or ">=" for the lower bound, got "<"`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid Event Pattern String Value",
+					"A string value was provided that is not valid event pattern string format.\n\n"+
+						"Error: "+testCase.expectedError+"\n"+
+						"Given Value: "+testCase.pattern.ValueString()+"\n",
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.pattern.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEventPatternValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern         jsontypes.EventPattern
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			pattern: jsontypes.EventPattern{},
+		},
+		"null": {
+			pattern: jsontypes.NewEventPatternNull(),
+		},
+		"unknown": {
+			pattern: jsontypes.NewEventPatternUnknown(),
+		},
+		"valid pattern": {
+			pattern: jsontypes.NewEventPatternValue(`{"source":["aws.ec2"]}`),
+		},
+		"invalid pattern - scalar field": {
+			pattern: jsontypes.NewEventPatternValue(`{"source":1}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Event Pattern String Value: "+
+					"A string value was provided that is not valid event pattern string format.\n\n"+
+					"Error: at \"/source\": expected an object or array, got number\n"+
+					"Given Value: {\"source\":1}\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.pattern.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEventPatternUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.EventPattern
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"event pattern value is null ": {
+			json: jsontypes.NewEventPatternNull(),
+			target: struct {
+				Source []string `json:"source"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Event Pattern Unmarshal Error",
+					"event pattern string value is null",
+				),
+			},
+		},
+		"event pattern value is unknown ": {
+			json: jsontypes.NewEventPatternUnknown(),
+			target: struct {
+				Source []string `json:"source"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Event Pattern Unmarshal Error",
+					"event pattern string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewEventPatternValue(`{"source":["aws.ec2"]}`),
+			target: struct {
+				Source []string `json:"source"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Event Pattern Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Source []string \"json:\\\"source\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewEventPatternValue(`{"source":["aws.ec2","aws.s3"]}`),
+			target: &struct {
+				Source []string `json:"source"`
+			}{},
+			output: &struct {
+				Source []string `json:"source"`
+			}{
+				Source: []string{"aws.ec2", "aws.s3"},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,574 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/internal/jsonnumber"
+)
+
+// geoJSONError is an error in a GeoJSON text. If the error is within a feature of a feature collection, feature is the JSON
+// of that feature, so the offending feature can be shown rather than the whole text.
+type geoJSONError struct {
+	err     error
+	feature string
+}
+
+// geoJSONCoordinates validates the "coordinates" member at the given location for each geometry type, other than
+// GeometryCollection which has a "geometries" member instead.
+var geoJSONCoordinates = map[string]func(location []string, coordinates any) error{
+	"Point":           validateGeoJSONPosition,
+	"MultiPoint":      validateGeoJSONArrayOf(validateGeoJSONPosition),
+	"LineString":      validateGeoJSONLineString,
+	"MultiLineString": validateGeoJSONArrayOf(validateGeoJSONLineString),
+	"Polygon":         validateGeoJSONPolygon,
+	"MultiPolygon":    validateGeoJSONArrayOf(validateGeoJSONPolygon),
+}
+
+// validateGeoJSON returns the errors in the GeoJSON text (RFC 7946). The object types, the number of elements of positions,
+// the number of positions of line strings and linear rings, whether linear rings are closed and the shape of bounding boxes
+// are validated. An error is returned for each invalid feature of a feature collection, so every offending feature is
+// reported, while other GeoJSON texts return at most one error. Errors describe the JSON Pointer (RFC 6901) of the
+// offending value.
+func validateGeoJSON(jsonStr string) []geoJSONError {
+	value, err := decodeValidJSON(jsonStr)
+	if err != nil {
+		return []geoJSONError{{err: err}}
+	}
+
+	object, objectType, err := geoJSONObject(nil, value)
+	if err != nil {
+		return []geoJSONError{{err: err}}
+	}
+
+	switch objectType {
+	case "FeatureCollection":
+		features, err := geoJSONArrayMember(nil, object, "features")
+		if err != nil {
+			return []geoJSONError{{err: err}}
+		}
+
+		var errs []geoJSONError
+
+		for i, feature := range features {
+			if err := validateGeoJSONFeature(indexLocation([]string{"features"}, i), feature); err != nil {
+				featureJSON, _ := json.Marshal(feature)
+
+				errs = append(errs, geoJSONError{err: err, feature: string(featureJSON)})
+			}
+		}
+
+		return errs
+	case "Feature":
+		err = validateGeoJSONFeature(nil, value)
+	default:
+		err = validateGeoJSONGeometry(nil, value)
+	}
+
+	if err != nil {
+		return []geoJSONError{{err: err}}
+	}
+
+	return nil
+}
+
+// geoJSONObject returns the GeoJSON object at the given location and its type, after validating its bounding box.
+func geoJSONObject(location []string, value any) (map[string]any, string, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, "", fmt.Errorf("at %q: expected a GeoJSON object, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	typeMember, ok := object["type"]
+	if !ok {
+		return nil, "", fmt.Errorf("at %q: missing required member \"type\"", formatJSONPointer(location))
+	}
+
+	objectType, ok := typeMember.(string)
+	if !ok {
+		return nil, "", fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(childLocation(location, "type")), jsonTypeName(typeMember))
+	}
+
+	if bbox, ok := object["bbox"]; ok {
+		if err := validateGeoJSONBoundingBox(childLocation(location, "bbox"), bbox); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return object, objectType, nil
+}
+
+// geoJSONArrayMember returns the required array member with the given name of the GeoJSON object at the given location.
+func geoJSONArrayMember(location []string, object map[string]any, name string) ([]any, error) {
+	member, ok := object[name]
+	if !ok {
+		return nil, fmt.Errorf("at %q: missing required member %q", formatJSONPointer(location), name)
+	}
+
+	array, ok := member.([]any)
+	if !ok {
+		return nil, fmt.Errorf("at %q: expected an array, got %s", formatJSONPointer(childLocation(location, name)), jsonTypeName(member))
+	}
+
+	return array, nil
+}
+
+// validateGeoJSONFeature returns an error if the value at the given location is not a valid Feature object.
+func validateGeoJSONFeature(location []string, value any) error {
+	object, objectType, err := geoJSONObject(location, value)
+	if err != nil {
+		return err
+	}
+
+	if objectType != "Feature" {
+		return fmt.Errorf("at %q: expected a Feature, got %q", formatJSONPointer(childLocation(location, "type")), objectType)
+	}
+
+	if id, ok := object["id"]; ok {
+		switch id.(type) {
+		case string, json.Number:
+		default:
+			return fmt.Errorf("at %q: expected a string or number, got %s", formatJSONPointer(childLocation(location, "id")), jsonTypeName(id))
+		}
+	}
+
+	if properties, ok := object["properties"]; ok {
+		switch properties.(type) {
+		case map[string]any, nil:
+		default:
+			return fmt.Errorf("at %q: expected an object or null, got %s", formatJSONPointer(childLocation(location, "properties")), jsonTypeName(properties))
+		}
+	}
+
+	geometry, ok := object["geometry"]
+	if !ok {
+		return fmt.Errorf("at %q: missing required member \"geometry\"", formatJSONPointer(location))
+	}
+
+	// A feature which is not located has a null geometry.
+	if geometry == nil {
+		return nil
+	}
+
+	return validateGeoJSONGeometry(childLocation(location, "geometry"), geometry)
+}
+
+// validateGeoJSONGeometry returns an error if the value at the given location is not a valid Geometry object.
+func validateGeoJSONGeometry(location []string, value any) error {
+	object, objectType, err := geoJSONObject(location, value)
+	if err != nil {
+		return err
+	}
+
+	if objectType == "GeometryCollection" {
+		geometries, err := geoJSONArrayMember(location, object, "geometries")
+		if err != nil {
+			return err
+		}
+
+		for i, geometry := range geometries {
+			if err := validateGeoJSONGeometry(indexLocation(childLocation(location, "geometries"), i), geometry); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	validateCoordinates, ok := geoJSONCoordinates[objectType]
+	if !ok {
+		return fmt.Errorf("at %q: %q is not a GeoJSON geometry type", formatJSONPointer(childLocation(location, "type")), objectType)
+	}
+
+	coordinates, err := geoJSONArrayMember(location, object, "coordinates")
+	if err != nil {
+		return err
+	}
+
+	// Empty coordinates are allowed for any geometry type, and may be interpreted as a null geometry.
+	if len(coordinates) == 0 {
+		return nil
+	}
+
+	return validateCoordinates(childLocation(location, "coordinates"), coordinates)
+}
+
+// validateGeoJSONArrayOf returns a function which validates each element of an array with the given function, such as the
+// coordinates of the Multi* geometry types.
+func validateGeoJSONArrayOf(validateElement func(location []string, value any) error) func(location []string, value any) error {
+	return func(location []string, value any) error {
+		array, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("at %q: expected an array, got %s", formatJSONPointer(location), jsonTypeName(value))
+		}
+
+		for i, element := range array {
+			if err := validateElement(indexLocation(location, i), element); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// validateGeoJSONPosition returns an error if the value at the given location is not a position, which is an array of a
+// longitude, latitude and optional elevation. Positions with more elements are not accepted, as RFC 7946 does not define
+// their meaning.
+func validateGeoJSONPosition(location []string, value any) error {
+	_, err := geoJSONPosition(location, value)
+
+	return err
+}
+
+// geoJSONPosition returns the numbers of the position at the given location, or an error if the value is not a position,
+// as with validateGeoJSONPosition.
+func geoJSONPosition(location []string, value any) ([]json.Number, error) {
+	elements, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("at %q: expected a position, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	if len(elements) != 2 && len(elements) != 3 {
+		return nil, fmt.Errorf("at %q: position must have 2 or 3 elements, got %d", formatJSONPointer(location), len(elements))
+	}
+
+	position := make([]json.Number, len(elements))
+
+	for i, element := range elements {
+		number, ok := element.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("at %q: expected a number, got %s", formatJSONPointer(indexLocation(location, i)), jsonTypeName(element))
+		}
+
+		position[i] = number
+	}
+
+	return position, nil
+}
+
+// geoJSONPositions returns the positions of the array at the given location, or an error if the value is not an array of
+// positions.
+func geoJSONPositions(location []string, value any) ([][]json.Number, error) {
+	array, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("at %q: expected an array, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	positions := make([][]json.Number, len(array))
+
+	for i, element := range array {
+		position, err := geoJSONPosition(indexLocation(location, i), element)
+		if err != nil {
+			return nil, err
+		}
+
+		positions[i] = position
+	}
+
+	return positions, nil
+}
+
+// validateGeoJSONLineString returns an error if the value at the given location is not an array of two or more positions.
+func validateGeoJSONLineString(location []string, value any) error {
+	positions, err := geoJSONPositions(location, value)
+	if err != nil {
+		return err
+	}
+
+	if len(positions) < 2 {
+		return fmt.Errorf("at %q: line string must have at least 2 positions, got %d", formatJSONPointer(location), len(positions))
+	}
+
+	return nil
+}
+
+// validateGeoJSONLinearRing returns an error if the value at the given location is not a linear ring, which is a closed line
+// string of four or more positions where the first and last positions are identical.
+func validateGeoJSONLinearRing(location []string, value any) error {
+	positions, err := geoJSONPositions(location, value)
+	if err != nil {
+		return err
+	}
+
+	if len(positions) < 4 {
+		return fmt.Errorf("at %q: linear ring must have at least 4 positions, got %d", formatJSONPointer(location), len(positions))
+	}
+
+	first, last := positions[0], positions[len(positions)-1]
+
+	if !geoJSONPositionsIdentical(first, last) {
+		firstJSON, _ := json.Marshal(first)
+		lastJSON, _ := json.Marshal(last)
+
+		return fmt.Errorf("at %q: linear ring is not closed, the first position %s and last position %s must be identical",
+			formatJSONPointer(location), firstJSON, lastJSON)
+	}
+
+	return nil
+}
+
+// validateGeoJSONPolygon returns an error if the value at the given location is not an array of linear rings.
+func validateGeoJSONPolygon(location []string, value any) error {
+	return validateGeoJSONArrayOf(validateGeoJSONLinearRing)(location, value)
+}
+
+// geoJSONPositionsIdentical returns true if both positions have the same number of elements with the same exact values.
+func geoJSONPositionsIdentical(a, b []json.Number) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if equal, err := jsonnumber.Equal(a[i].String(), b[i].String()); err != nil || !equal {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateGeoJSONBoundingBox returns an error if the value at the given location is not a bounding box, which is an array of
+// the southwesterly position followed by the northeasterly position, with 2 or 3 elements each. The western longitude may
+// be greater than the eastern longitude for a bounding box which crosses the antimeridian, but the southern latitude and
+// lowest elevation must not be greater than the northern latitude and highest elevation.
+func validateGeoJSONBoundingBox(location []string, value any) error {
+	bbox, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("at %q: expected an array, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	if len(bbox) != 4 && len(bbox) != 6 {
+		return fmt.Errorf("at %q: bounding box must have 4 or 6 elements, got %d", formatJSONPointer(location), len(bbox))
+	}
+
+	bounds := make([]float64, len(bbox))
+
+	for i, element := range bbox {
+		number, ok := element.(json.Number)
+		if !ok {
+			return fmt.Errorf("at %q: expected a number, got %s", formatJSONPointer(indexLocation(location, i)), jsonTypeName(element))
+		}
+
+		// Numbers out of range are parsed as infinity, which is still ordered.
+		bounds[i], _ = strconv.ParseFloat(number.String(), 64)
+	}
+
+	dimensions := len(bbox) / 2
+
+	if south, north := bounds[1], bounds[dimensions+1]; south > north {
+		return fmt.Errorf("at %q: bounding box southern latitude %s is greater than northern latitude %s", formatJSONPointer(location),
+			bbox[1], bbox[dimensions+1])
+	}
+
+	if dimensions == 3 && bounds[2] > bounds[5] {
+		return fmt.Errorf("at %q: bounding box lowest elevation %s is greater than highest elevation %s", formatJSONPointer(location),
+			bbox[2], bbox[5])
+	}
+
+	return nil
+}
+
+// jsonTypeName returns the name of the JSON type of the decoded value, for use in error messages.
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
+
+// geoJSONEqual returns true if both GeoJSON strings are semantically equal. The linear rings of polygons are rotated to
+// begin at the same position, then the GeoJSON objects are compared like Normalized, except that numbers in positions and
+// bounding boxes are equal if they differ by at most half a unit in the precision decimal place, or that of
+// DefaultCoordinatePrecision if not positive.
+func geoJSONEqual(ctx context.Context, s1, s2 string, precision int) (bool, error) {
+	priorValue, err := decodeJSON(s1)
+	if err != nil {
+		return false, err
+	}
+
+	newValue, err := decodeJSON(s2)
+	if err != nil {
+		return false, err
+	}
+
+	// Coordinates are compared with a tolerance rather than rounded, so that numbers on either side of a rounding boundary
+	// are still equal, such as 1.0000004999 and 1.0000005001. Positions are compared at the locations of the prior value,
+	// so those of the new value are not needed. Without any paths, the tolerance would apply to every number.
+	tolerance := 0.5 * math.Pow10(-coordinatePrecision(precision))
+	r := &equalityRules{
+		numberTolerancePaths: geoJSONCoordinatePaths(nil, priorValue),
+	}
+
+	if len(r.numberTolerancePaths) > 0 {
+		r.absoluteTolerance = tolerance
+	}
+
+	alignGeoJSON(ctx, priorValue, newValue, tolerance)
+
+	return r.valuesEqual(ctx, priorValue, newValue), nil
+}
+
+// geoJSONCoordinateDepths are the number of array levels of the coordinates of each geometry type, down to the numbers
+// of each position.
+var geoJSONCoordinateDepths = map[string]int{
+	"Point":           1,
+	"MultiPoint":      2,
+	"LineString":      2,
+	"MultiLineString": 3,
+	"Polygon":         3,
+	"MultiPolygon":    4,
+}
+
+// geoJSONCoordinatePaths returns the paths of every number in the positions and bounding boxes of the decoded GeoJSON
+// object at the given location. Numbers in feature properties and foreign members are not included.
+func geoJSONCoordinatePaths(location []string, value any) []jsonPointer {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	var paths []jsonPointer
+
+	if _, ok := object["bbox"]; ok {
+		paths = append(paths, childLocation(childLocation(location, "bbox"), jsonPointerWildcard))
+	}
+
+	switch object["type"] {
+	case "FeatureCollection":
+		if features, ok := object["features"].([]any); ok {
+			for i, feature := range features {
+				paths = append(paths, geoJSONCoordinatePaths(indexLocation(childLocation(location, "features"), i), feature)...)
+			}
+		}
+	case "Feature":
+		paths = append(paths, geoJSONCoordinatePaths(childLocation(location, "geometry"), object["geometry"])...)
+	case "GeometryCollection":
+		if geometries, ok := object["geometries"].([]any); ok {
+			for i, geometry := range geometries {
+				paths = append(paths, geoJSONCoordinatePaths(indexLocation(childLocation(location, "geometries"), i), geometry)...)
+			}
+		}
+	default:
+		typ, _ := object["type"].(string)
+
+		if depth, ok := geoJSONCoordinateDepths[typ]; ok {
+			path := childLocation(location, "coordinates")
+
+			for range depth {
+				path = childLocation(path, jsonPointerWildcard)
+			}
+
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// alignGeoJSON rotates the closed linear rings of polygons in the decoded new GeoJSON object to begin at the position
+// which makes them equal to the corresponding rings of the decoded prior GeoJSON object, as the start point of a linear
+// ring is not significant. Each rotation is tried against the prior ring, rather than rotating both rings to a least
+// position, so that positions either side of a rounding boundary cannot select different start points. Feature
+// properties and foreign members are not modified. The decoded new value is modified in place.
+func alignGeoJSON(ctx context.Context, priorValue, newValue any, tolerance float64) {
+	priorObject, ok := priorValue.(map[string]any)
+	if !ok {
+		return
+	}
+
+	newObject, ok := newValue.(map[string]any)
+	if !ok || priorObject["type"] != newObject["type"] {
+		return
+	}
+
+	align := func(priorElement, newElement any) {
+		alignGeoJSON(ctx, priorElement, newElement, tolerance)
+	}
+
+	switch priorObject["type"] {
+	case "FeatureCollection":
+		forEachGeoJSONPair(priorObject["features"], newObject["features"], align)
+	case "Feature":
+		alignGeoJSON(ctx, priorObject["geometry"], newObject["geometry"], tolerance)
+	case "GeometryCollection":
+		forEachGeoJSONPair(priorObject["geometries"], newObject["geometries"], align)
+	case "Polygon":
+		alignGeoJSONPolygon(ctx, priorObject["coordinates"], newObject["coordinates"], tolerance)
+	case "MultiPolygon":
+		forEachGeoJSONPair(priorObject["coordinates"], newObject["coordinates"], func(priorPolygon, newPolygon any) {
+			alignGeoJSONPolygon(ctx, priorPolygon, newPolygon, tolerance)
+		})
+	}
+}
+
+// forEachGeoJSONPair calls f with the elements at each index of both the prior and new arrays.
+func forEachGeoJSONPair(priorValue, newValue any, f func(priorElement, newElement any)) {
+	priorArray, _ := priorValue.([]any)
+	newArray, _ := newValue.([]any)
+
+	for i := range min(len(priorArray), len(newArray)) {
+		f(priorArray[i], newArray[i])
+	}
+}
+
+// alignGeoJSONPolygon rotates each linear ring of the new polygon coordinates to match the ring at the same index of the
+// prior polygon coordinates.
+func alignGeoJSONPolygon(ctx context.Context, priorCoordinates, newCoordinates any, tolerance float64) {
+	priorRings, _ := priorCoordinates.([]any)
+	newRings, _ := newCoordinates.([]any)
+
+	// Linear rings only contain positions, so the tolerance applies to every number.
+	r := &equalityRules{
+		absoluteTolerance: tolerance,
+	}
+
+	for i := range min(len(priorRings), len(newRings)) {
+		priorRing, priorOK := priorRings[i].([]any)
+		newRing, newOK := newRings[i].([]any)
+
+		if priorOK && newOK {
+			newRings[i] = alignGeoJSONLinearRing(ctx, r, priorRing, newRing)
+		}
+	}
+}
+
+// alignGeoJSONLinearRing returns the first rotation of the closed new linear ring which is equal to the prior linear ring
+// according to the rules. The new ring is returned as is if it is not closed, or if no rotation is equal.
+func alignGeoJSONLinearRing(ctx context.Context, r *equalityRules, priorRing, newRing []any) []any {
+	if len(newRing) < 4 || len(newRing) != len(priorRing) || !r.valuesEqual(ctx, newRing[0], newRing[len(newRing)-1]) {
+		return newRing
+	}
+
+	// The last position repeats the first, so only the other positions are rotated.
+	open := newRing[:len(newRing)-1]
+
+	for start := range open {
+		rotated := make([]any, 0, len(newRing))
+		rotated = append(rotated, open[start:]...)
+		rotated = append(rotated, open[:start]...)
+		rotated = append(rotated, open[start])
+
+		if r.valuesEqual(ctx, priorRing, rotated) {
+			return rotated
+		}
+	}
+
+	return newRing
+}
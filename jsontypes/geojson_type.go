@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*GeoJSONType)(nil)
+)
+
+// DefaultCoordinatePrecision is the number of decimal places to which the coordinates of GeoJSON values are compared if the
+// GeoJSONType does not configure CoordinatePrecision. As noted in RFC 7946, six decimal places of a longitude or latitude
+// is about 10 centimeters.
+const DefaultCoordinatePrecision = 6
+
+// GeoJSONType is an attribute type that represents a valid GeoJSON string (RFC 7946). Geometry types, the number of
+// elements of positions, closed linear rings and the shape of bounding boxes are validated. Semantic equality logic is
+// defined for GeoJSONType such that coordinates are compared to a number of decimal places, the start point of polygon
+// linear rings is ignored and other inconsequential differences between the JSON strings (whitespace, property order, etc)
+// are ignored, like NormalizedType.
+//
+// CoordinatePrecision can be set to compare coordinates to more or fewer decimal places. Types with a different effective
+// CoordinatePrecision are not equal, where zero, negative and DefaultCoordinatePrecision are all the same.
+//
+// Values created by the NewGeoJSONValue and other NewGeoJSON functions have a GeoJSONType without CoordinatePrecision.
+// Use the NewValue and other New methods of the type instead for values of a configured type, such as the elements of a
+// collection of that type, as every element must have the element type of the collection.
+type GeoJSONType struct {
+	basetypes.StringType
+
+	// CoordinatePrecision is the number of decimal places to which positions and bounding boxes are compared, so their
+	// numbers are equal if they differ by at most half a unit in that decimal place. If zero or negative,
+	// DefaultCoordinatePrecision is used.
+	CoordinatePrecision int
+}
+
+// String returns a human readable string of the type name.
+func (t GeoJSONType) String() string {
+	if precision := coordinatePrecision(t.CoordinatePrecision); precision != DefaultCoordinatePrecision {
+		return "jsontypes.GeoJSONType[CoordinatePrecision: " + strconv.Itoa(precision) + "]"
+	}
+
+	return "jsontypes.GeoJSONType"
+}
+
+// ValueType returns the Value type.
+func (t GeoJSONType) ValueType(ctx context.Context) attr.Value {
+	return GeoJSON{
+		coordinatePrecision: t.CoordinatePrecision,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t GeoJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(GeoJSONType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType) && coordinatePrecision(t.CoordinatePrecision) == coordinatePrecision(other.CoordinatePrecision)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t GeoJSONType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return GeoJSON{
+		StringValue:         in,
+		coordinatePrecision: t.CoordinatePrecision,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t GeoJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// coordinatePrecision returns the effective number of decimal places for the configured precision, which is
+// DefaultCoordinatePrecision if the configured precision is not positive.
+func coordinatePrecision(precision int) int {
+	if precision <= 0 {
+		return DefaultCoordinatePrecision
+	}
+
+	return precision
+}
+
+// NewNull creates a GeoJSON with a null value and the CoordinatePrecision of the type. Determine whether the value is
+// null via IsNull method.
+func (t GeoJSONType) NewNull() GeoJSON {
+	return GeoJSON{
+		StringValue:         basetypes.NewStringNull(),
+		coordinatePrecision: t.CoordinatePrecision,
+	}
+}
+
+// NewUnknown creates a GeoJSON with an unknown value and the CoordinatePrecision of the type. Determine whether the
+// value is unknown via IsUnknown method.
+func (t GeoJSONType) NewUnknown() GeoJSON {
+	return GeoJSON{
+		StringValue:         basetypes.NewStringUnknown(),
+		coordinatePrecision: t.CoordinatePrecision,
+	}
+}
+
+// NewValue creates a GeoJSON with a known value and the CoordinatePrecision of the type. Access the value via
+// ValueString method.
+func (t GeoJSONType) NewValue(value string) GeoJSON {
+	return GeoJSON{
+		StringValue:         basetypes.NewStringValue(value),
+		coordinatePrecision: t.CoordinatePrecision,
+	}
+}
+
+// NewPointerValue creates a GeoJSON with a null value if nil or a known value, and the CoordinatePrecision of the type.
+// Access the value via ValueStringPointer method.
+func (t GeoJSONType) NewPointerValue(value *string) GeoJSON {
+	return GeoJSON{
+		StringValue:         basetypes.NewStringPointerValue(value),
+		coordinatePrecision: t.CoordinatePrecision,
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestGeoJSONTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}{
+		"true": {
+			in:          tftypes.NewValue(tftypes.String, `{"type":"Point","coordinates":[1,2]}`),
+			expectation: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,2]}`),
+		},
+		"unknown": {
+			in:          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expectation: jsontypes.NewGeoJSONUnknown(),
+		},
+		"null": {
+			in:          tftypes.NewValue(tftypes.String, nil),
+			expectation: jsontypes.NewGeoJSONNull(),
+		},
+		"wrongType": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := jsontypes.GeoJSONType{}.ValueFromTerraform(ctx, testCase.in)
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				if testCase.expectedErr != err.Error() {
+					t.Fatalf("Expected error to be %q, got %q", testCase.expectedErr, err.Error())
+				}
+				return
+			}
+			if err == nil && testCase.expectedErr != "" {
+				t.Fatalf("Expected error to be %q, didn't get an error", testCase.expectedErr)
+			}
+			if !got.Equal(testCase.expectation) {
+				t.Errorf("Expected %+v, got %+v", testCase.expectation, got)
+			}
+			if testCase.expectation.IsNull() != testCase.in.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", testCase.expectation.IsNull(), testCase.in.IsNull())
+			}
+			if testCase.expectation.IsUnknown() != !testCase.in.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", testCase.expectation.IsUnknown(), !testCase.in.IsKnown())
+			}
+		})
+	}
+}
+
+func TestGeoJSONTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      jsontypes.GeoJSONType
+		other    attr.Type
+		expected bool
+	}{
+		"equal - default coordinate precision": {
+			typ:      jsontypes.GeoJSONType{},
+			other:    jsontypes.GeoJSONType{},
+			expected: true,
+		},
+		"equal - same coordinate precision": {
+			typ:      jsontypes.GeoJSONType{CoordinatePrecision: 3},
+			other:    jsontypes.GeoJSONType{CoordinatePrecision: 3},
+			expected: true,
+		},
+		"equal - zero and default coordinate precision": {
+			typ:      jsontypes.GeoJSONType{},
+			other:    jsontypes.GeoJSONType{CoordinatePrecision: jsontypes.DefaultCoordinatePrecision},
+			expected: true,
+		},
+		"equal - negative and zero coordinate precision": {
+			typ:      jsontypes.GeoJSONType{CoordinatePrecision: -1},
+			other:    jsontypes.GeoJSONType{},
+			expected: true,
+		},
+		"not equal - different coordinate precision": {
+			typ:      jsontypes.GeoJSONType{CoordinatePrecision: 3},
+			other:    jsontypes.GeoJSONType{},
+			expected: false,
+		},
+		"not equal - different type": {
+			typ:      jsontypes.GeoJSONType{},
+			other:    jsontypes.NormalizedType{},
+			expected: false,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("Expected Equal to return: %t, but got: %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestGeoJSONTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      jsontypes.GeoJSONType
+		expected string
+	}{
+		"default coordinate precision": {
+			typ:      jsontypes.GeoJSONType{},
+			expected: "jsontypes.GeoJSONType",
+		},
+		"default coordinate precision configured": {
+			typ:      jsontypes.GeoJSONType{CoordinatePrecision: jsontypes.DefaultCoordinatePrecision},
+			expected: "jsontypes.GeoJSONType",
+		},
+		"negative coordinate precision": {
+			typ:      jsontypes.GeoJSONType{CoordinatePrecision: -1},
+			expected: "jsontypes.GeoJSONType",
+		},
+		"coordinate precision": {
+			typ:      jsontypes.GeoJSONType{CoordinatePrecision: 3},
+			expected: "jsontypes.GeoJSONType[CoordinatePrecision: 3]",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if got != testCase.expected {
+				t.Errorf("Expected String to return: %q, but got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestGeoJSONTypeNewValue(t *testing.T) {
+	t.Parallel()
+
+	typ := jsontypes.GeoJSONType{
+		CoordinatePrecision: 2,
+	}
+	value := `{"type":"Point","coordinates":[102.0,0.5]}`
+
+	testCases := map[string]struct {
+		value           jsontypes.GeoJSON
+		expectedNull    bool
+		expectedUnknown bool
+		expectedValue   *string
+	}{
+		"null": {
+			value:        typ.NewNull(),
+			expectedNull: true,
+		},
+		"unknown": {
+			value:           typ.NewUnknown(),
+			expectedUnknown: true,
+		},
+		"value": {
+			value:         typ.NewValue(value),
+			expectedValue: &value,
+		},
+		"pointer value": {
+			value:         typ.NewPointerValue(&value),
+			expectedValue: &value,
+		},
+		"pointer value - nil": {
+			value:        typ.NewPointerValue(nil),
+			expectedNull: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.value.Type(context.Background()); !got.Equal(typ) {
+				t.Errorf("Expected value type %s, got %s", typ, got)
+			}
+
+			if got := testCase.value.IsNull(); got != testCase.expectedNull {
+				t.Errorf("Expected IsNull %t, got %t", testCase.expectedNull, got)
+			}
+
+			if got := testCase.value.IsUnknown(); got != testCase.expectedUnknown {
+				t.Errorf("Expected IsUnknown %t, got %t", testCase.expectedUnknown, got)
+			}
+
+			var expectedString string
+			if testCase.expectedValue != nil {
+				expectedString = *testCase.expectedValue
+			}
+
+			if got := testCase.value.ValueString(); got != expectedString {
+				t.Errorf("Expected ValueString %q, got %q", expectedString, got)
+			}
+
+			// The pointer to an unknown value is not meaningful, so it is only checked for null and known values.
+			if !testCase.expectedUnknown {
+				got := testCase.value.ValueStringPointer()
+
+				switch {
+				case testCase.expectedValue == nil && got != nil:
+					t.Errorf("Expected nil ValueStringPointer, got %q", *got)
+				case testCase.expectedValue != nil && got == nil:
+					t.Errorf("Expected ValueStringPointer %q, got nil", *testCase.expectedValue)
+				case testCase.expectedValue != nil && *got != *testCase.expectedValue:
+					t.Errorf("Expected ValueStringPointer %q, got %q", *testCase.expectedValue, *got)
+				}
+			}
+
+			if _, diags := basetypes.NewListValue(typ, []attr.Value{testCase.value}); diags.HasError() {
+				t.Errorf("Unexpected diagnostics creating a list of the type: %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*GeoJSON)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*GeoJSON)(nil)
+	_ xattr.ValidateableAttribute                = (*GeoJSON)(nil)
+	_ function.ValidateableParameter             = (*GeoJSON)(nil)
+)
+
+// GeoJSON represents a valid GeoJSON string (RFC 7946), such as a Feature, FeatureCollection or Geometry object. Semantic
+// equality logic is defined for GeoJSON such that coordinates are compared to a number of decimal places, as APIs often
+// return coordinates with a different precision, and polygon linear rings which begin at a different position are equal.
+// Other inconsequential differences between the JSON strings (whitespace, property order, etc) are ignored, like
+// Normalized.
+type GeoJSON struct {
+	basetypes.StringValue
+
+	// coordinatePrecision is the CoordinatePrecision of the GeoJSONType that created this value.
+	coordinatePrecision int
+}
+
+// Type returns a GeoJSONType.
+func (v GeoJSON) Type(_ context.Context) attr.Type {
+	return GeoJSONType{
+		CoordinatePrecision: v.coordinatePrecision,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v GeoJSON) Equal(o attr.Value) bool {
+	other, ok := o.(GeoJSON)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given GeoJSON string value is semantically equal to the current GeoJSON string
+// value. When compared, the linear rings of polygons are rotated to begin at the same position and the GeoJSON objects are
+// then compared like Normalized, except that numbers in positions and bounding boxes are equal if they differ by at most
+// half a unit in the CoordinatePrecision decimal place of the type. Feature properties are compared like Normalized
+// without a tolerance.
+func (v GeoJSON) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(GeoJSON)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := geoJSONEqual(ctx, v.ValueString(), newValue.ValueString(), v.coordinatePrecision)
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid GeoJSON format (RFC 7946). An error diagnostic is returned for each invalid feature of a feature
+// collection.
+func (v GeoJSON) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	for _, err := range validateGeoJSON(v.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid GeoJSON String Value",
+			"A string value was provided that is not valid GeoJSON string format (RFC 7946).\n\n"+
+				"Error: "+err.err.Error()+"\n"+
+				v.givenGeoJSON(err),
+		)
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid GeoJSON format (RFC 7946).
+func (v GeoJSON) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	for _, err := range validateGeoJSON(v.ValueString()) {
+		resp.Error = function.ConcatFuncErrors(
+			resp.Error,
+			function.NewArgumentFuncError(
+				req.Position,
+				"Invalid GeoJSON String Value: "+
+					"A string value was provided that is not valid GeoJSON string format (RFC 7946).\n\n"+
+					"Error: "+err.err.Error()+"\n"+
+					v.givenGeoJSON(err),
+			),
+		)
+	}
+}
+
+// givenGeoJSON returns the line of a validation error detail which shows the offending feature, or the whole value if the
+// error is not within a feature of a feature collection.
+func (v GeoJSON) givenGeoJSON(err geoJSONError) string {
+	if err.feature != "" {
+		return "Given Feature: " + err.feature + "\n"
+	}
+
+	return "Given Value: " + v.ValueString() + "\n"
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the GeoJSON StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v GeoJSON) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("GeoJSON Unmarshal Error", "geojson string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("GeoJSON Unmarshal Error", "geojson string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("GeoJSON Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewGeoJSONNull creates a GeoJSON with a null value. Determine whether the value is null via IsNull method.
+func NewGeoJSONNull() GeoJSON {
+	return GeoJSON{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewGeoJSONUnknown creates a GeoJSON with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewGeoJSONUnknown() GeoJSON {
+	return GeoJSON{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewGeoJSONValue creates a GeoJSON with a known value. Access the value via ValueString method.
+func NewGeoJSONValue(value string) GeoJSON {
+	return GeoJSON{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewGeoJSONPointerValue creates a GeoJSON with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewGeoJSONPointerValue(value *string) GeoJSON {
+	return GeoJSON{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
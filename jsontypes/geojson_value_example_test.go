@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type GeoJSONResourceModel struct {
+	Geometry jsontypes.GeoJSON `tfsdk:"geometry"`
+}
+
+type GeoJSONPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+func ExampleGeoJSON_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := GeoJSONResourceModel{
+		Geometry: jsontypes.NewGeoJSONValue(`{"type": "Point", "coordinates": [-122.4194, 37.7749]}`),
+	}
+
+	// Check that the GeoJSON data is known and able to be unmarshalled
+	if !data.Geometry.IsNull() && !data.Geometry.IsUnknown() {
+		var point GeoJSONPoint
+
+		diags.Append(data.Geometry.Unmarshal(&point)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: {Point [-122.4194 37.7749]}
+		fmt.Printf("%v\n", point)
+	}
+}
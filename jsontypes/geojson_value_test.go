@@ -0,0 +1,517 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestGeoJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		coordinatePrecision int
+		currentJson         string
+		givenJson           basetypes.StringValuable
+		expectedMatch       bool
+		expectedDiags       diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentJson:   `{"type":"Point","coordinates":[102.0,0.5]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[102.0,0.5]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - json whitespace and field order difference": {
+			currentJson:   "{\n  \"coordinates\": [102.0, 0.5],\n  \"type\": \"Point\"\n}",
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[102.0,0.5]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - coordinate precision difference": {
+			currentJson:   `{"type":"LineString","coordinates":[[-122.41941610000001,37.7749302],[-122.4, 37.8]]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"LineString","coordinates":[[-122.419416,37.77493],[-122.40,37.80]]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - coordinate rounds to zero": {
+			currentJson:   `{"type":"Point","coordinates":[-0.0000001,0]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[0,0.0000001]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - coordinates either side of rounding boundary": {
+			currentJson:   `{"type":"Point","coordinates":[1.0000004999,2]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1.0000005001,2]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - polygon ring rotation either side of rounding boundary": {
+			currentJson:   `{"type":"Polygon","coordinates":[[[0,0],[1.0000004999,0],[1,1],[0,0]]]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Polygon","coordinates":[[[1.0000005001,0],[1,1],[0,0],[1.0000005001,0]]]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - polygon ring start either side of rounding boundary": {
+			currentJson:   `{"type":"Polygon","coordinates":[[[1.0000004999,0],[1,1],[2,1],[2,0],[1.0000004999,0]]]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Polygon","coordinates":[[[1.0000005001,0],[1,1],[2,1],[2,0],[1.0000005001,0]]]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - rotated polygon ring start either side of rounding boundary": {
+			currentJson:   `{"type":"Polygon","coordinates":[[[1.0000004999,0],[1,1],[2,1],[2,0],[1.0000004999,0]]]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Polygon","coordinates":[[[2,1],[2,0],[1.0000005001,0],[1,1],[2,1]]]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - bbox precision difference": {
+			currentJson:   `{"type":"Point","coordinates":[1,2],"bbox":[1.0000001,2,1,2]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,2],"bbox":[1,2,1,2]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - configured coordinate precision": {
+			coordinatePrecision: 2,
+			currentJson:         `{"type":"Point","coordinates":[102.001,0.504]}`,
+			givenJson:           jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[102,0.5]}`),
+			expectedMatch:       true,
+		},
+		"semantically equal - polygon ring rotation": {
+			currentJson:   `{"type":"Polygon","coordinates":[[[0,0],[10,0],[10,10],[0,10],[0,0]],[[2,2],[2,4],[4,4],[2,2]]]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Polygon","coordinates":[[[10,10],[0,10],[0,0],[10,0],[10,10]],[[4,4],[2,2],[2,4],[4,4]]]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - multipolygon ring rotation in feature collection": {
+			currentJson: `{"type":"FeatureCollection","features":[{"type":"Feature","properties":{"name":"a"},` +
+				`"geometry":{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]]]}}]}`,
+			givenJson: jsontypes.NewGeoJSONValue(`{"type":"FeatureCollection","features":[{"type":"Feature","properties":{"name":"a"},` +
+				`"geometry":{"type":"MultiPolygon","coordinates":[[[[1,1],[0,0],[1,0],[1,1]]]]}}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - geometry collection": {
+			currentJson:   `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1.0000001,2]}]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2]}]}`),
+			expectedMatch: true,
+		},
+		"not equal - coordinate difference beyond precision": {
+			currentJson:   `{"type":"Point","coordinates":[102.00001,0.5]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[102.0,0.5]}`),
+			expectedMatch: false,
+		},
+		"not equal - polygon ring reversed": {
+			currentJson:   `{"type":"Polygon","coordinates":[[[0,0],[10,0],[10,10],[0,10],[0,0]]]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Polygon","coordinates":[[[0,0],[0,10],[10,10],[10,0],[0,0]]]}`),
+			expectedMatch: false,
+		},
+		"not equal - line string rotation": {
+			currentJson:   `{"type":"LineString","coordinates":[[0,0],[1,1],[0,0]]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"LineString","coordinates":[[1,1],[0,0],[1,1]]}`),
+			expectedMatch: false,
+		},
+		"not equal - feature property precision difference": {
+			currentJson:   `{"type":"Feature","properties":{"area":1.0000001},"geometry":null}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":"Feature","properties":{"area":1},"geometry":null}`),
+			expectedMatch: false,
+		},
+		"error - invalid json": {
+			currentJson:   `{"type":"Point","coordinates":[1,2]}`,
+			givenJson:     jsontypes.NewGeoJSONValue(`{"type":`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+		"error - not given geojson value": {
+			currentJson:   `{"type":"Point","coordinates":[1,2]}`,
+			givenJson:     basetypes.NewStringValue(`{"type":"Point","coordinates":[1,2]}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.GeoJSON\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typ := jsontypes.GeoJSONType{CoordinatePrecision: testCase.coordinatePrecision}
+
+			valuable, diags := typ.ValueFromString(context.Background(), basetypes.NewStringValue(testCase.currentJson))
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics creating value: %v", diags)
+			}
+
+			currentJson, ok := valuable.(jsontypes.GeoJSON)
+			if !ok {
+				t.Fatalf("Expected jsontypes.GeoJSON, got %T", valuable)
+			}
+
+			match, diags := currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeoJSONValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	// invalidError returns the diagnostic for an invalid GeoJSON value with the given error.
+	invalidError := func(err, given string) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(
+			path.Root("test"),
+			"Invalid GeoJSON String Value",
+			"A string value was provided that is not valid GeoJSON string format (RFC 7946).\n\n"+
+				"Error: "+err+"\n"+
+				given+"\n",
+		)
+	}
+
+	testCases := map[string]struct {
+		geoJSON       jsontypes.GeoJSON
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			geoJSON: jsontypes.GeoJSON{},
+		},
+		"null": {
+			geoJSON: jsontypes.NewGeoJSONNull(),
+		},
+		"unknown": {
+			geoJSON: jsontypes.NewGeoJSONUnknown(),
+		},
+		"valid point": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[102.0,0.5,10]}`),
+		},
+		"valid empty geometry": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"MultiPolygon","coordinates":[]}`),
+		},
+		"valid feature with null geometry": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Feature","id":1,"properties":null,"geometry":null}`),
+		},
+		"valid feature collection": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"FeatureCollection","bbox":[170,-10,-170,10],"features":[` +
+				`{"type":"Feature","id":"a","properties":{"name":"line"},"geometry":{"type":"LineString","coordinates":[[170,0],[-170,0]]}},` +
+				`{"type":"Feature","properties":{},"geometry":{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}},` +
+				`{"type":"Feature","properties":{},"geometry":{"type":"GeometryCollection","geometries":[{"type":"MultiPoint","coordinates":[[0,0]]}]}}` +
+				`]}`),
+		},
+		"invalid json - bracket mismatch": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,2}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					"invalid character '}' after array element",
+					`Given Value: {"type":"Point","coordinates":[1,2}`,
+				),
+			},
+		},
+		"invalid object - array": {
+			geoJSON: jsontypes.NewGeoJSONValue(`[1,2]`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(`at "": expected a GeoJSON object, got array`, `Given Value: [1,2]`),
+			},
+		},
+		"invalid type - missing": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"coordinates":[1,2]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(`at "": missing required member "type"`, `Given Value: {"coordinates":[1,2]}`),
+			},
+		},
+		"invalid type - unknown geometry type": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Circle","coordinates":[1,2]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(`at "/type": "Circle" is not a GeoJSON geometry type`, `Given Value: {"type":"Circle","coordinates":[1,2]}`),
+			},
+		},
+		"invalid position - arity": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"MultiPoint","coordinates":[[1,2],[1,2,3,4]]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/coordinates/1": position must have 2 or 3 elements, got 4`,
+					`Given Value: {"type":"MultiPoint","coordinates":[[1,2],[1,2,3,4]]}`,
+				),
+			},
+		},
+		"invalid position - not a number": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,"2"]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(`at "/coordinates/1": expected a number, got string`, `Given Value: {"type":"Point","coordinates":[1,"2"]}`),
+			},
+		},
+		"invalid line string - single position": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"LineString","coordinates":[[1,2]]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/coordinates": line string must have at least 2 positions, got 1`,
+					`Given Value: {"type":"LineString","coordinates":[[1,2]]}`,
+				),
+			},
+		},
+		"invalid linear ring - too few positions": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/coordinates/0": linear ring must have at least 4 positions, got 3`,
+					`Given Value: {"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`,
+				),
+			},
+		},
+		"invalid linear ring - not closed": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,1]]]]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/coordinates/0/0": linear ring is not closed, the first position [0,0] and last position [0,1] must be identical`,
+					`Given Value: {"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,1]]]]}`,
+				),
+			},
+		},
+		"invalid bbox - element count": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,2],"bbox":[1,2,3]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/bbox": bounding box must have 4 or 6 elements, got 3`,
+					`Given Value: {"type":"Point","coordinates":[1,2],"bbox":[1,2,3]}`,
+				),
+			},
+		},
+		"invalid bbox - southern latitude greater than northern latitude": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,2],"bbox":[1,10,1,-10]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/bbox": bounding box southern latitude 10 is greater than northern latitude -10`,
+					`Given Value: {"type":"Point","coordinates":[1,2],"bbox":[1,10,1,-10]}`,
+				),
+			},
+		},
+		"invalid bbox - lowest elevation greater than highest elevation": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,2,3],"bbox":[1,2,5,1,2,3]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/bbox": bounding box lowest elevation 5 is greater than highest elevation 3`,
+					`Given Value: {"type":"Point","coordinates":[1,2,3],"bbox":[1,2,5,1,2,3]}`,
+				),
+			},
+		},
+		"invalid feature - missing geometry": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Feature","properties":{}}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(`at "": missing required member "geometry"`, `Given Value: {"type":"Feature","properties":{}}`),
+			},
+		},
+		"invalid feature - geometry is a feature": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Feature","geometry":{"type":"Feature","geometry":null}}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/geometry/type": "Feature" is not a GeoJSON geometry type`,
+					`Given Value: {"type":"Feature","geometry":{"type":"Feature","geometry":null}}`,
+				),
+			},
+		},
+		"invalid feature collection - error for each invalid feature": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"FeatureCollection","features":[` +
+				`{"type":"Feature","id":"ok","geometry":{"type":"Point","coordinates":[1,2]}},` +
+				`{"type":"Feature","id":"open","geometry":{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}},` +
+				`{"type":"Point","coordinates":[1,2]}` +
+				`]}`),
+			expectedDiags: diag.Diagnostics{
+				invalidError(
+					`at "/features/1/geometry/coordinates/0": linear ring is not closed, the first position [0,0] and last position [0,1] must be identical`,
+					`Given Feature: {"geometry":{"coordinates":[[[0,0],[1,0],[1,1],[0,1]]],"type":"Polygon"},"id":"open","type":"Feature"}`,
+				),
+				invalidError(
+					`at "/features/2/type": expected a Feature, got "Point"`,
+					`Given Feature: {"coordinates":[1,2],"type":"Point"}`,
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.geoJSON.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeoJSONValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		geoJSON         jsontypes.GeoJSON
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			geoJSON: jsontypes.GeoJSON{},
+		},
+		"null": {
+			geoJSON: jsontypes.NewGeoJSONNull(),
+		},
+		"unknown": {
+			geoJSON: jsontypes.NewGeoJSONUnknown(),
+		},
+		"valid point": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,2]}`),
+		},
+		"invalid position - arity": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1]}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid GeoJSON String Value: "+
+					"A string value was provided that is not valid GeoJSON string format (RFC 7946).\n\n"+
+					"Error: at \"/coordinates\": position must have 2 or 3 elements, got 1\n"+
+					"Given Value: {\"type\":\"Point\",\"coordinates\":[1]}\n",
+			),
+		},
+		"invalid feature collection - error for each invalid feature": {
+			geoJSON: jsontypes.NewGeoJSONValue(`{"type":"FeatureCollection","features":[{"type":"Point","coordinates":[1,2]},{"type":"Feature"}]}`),
+			expectedFuncErr: function.ConcatFuncErrors(
+				function.NewArgumentFuncError(
+					0,
+					"Invalid GeoJSON String Value: "+
+						"A string value was provided that is not valid GeoJSON string format (RFC 7946).\n\n"+
+						"Error: at \"/features/0/type\": expected a Feature, got \"Point\"\n"+
+						"Given Feature: {\"coordinates\":[1,2],\"type\":\"Point\"}\n",
+				),
+				function.NewArgumentFuncError(
+					0,
+					"Invalid GeoJSON String Value: "+
+						"A string value was provided that is not valid GeoJSON string format (RFC 7946).\n\n"+
+						"Error: at \"/features/1\": missing required member \"geometry\"\n"+
+						"Given Feature: {\"type\":\"Feature\"}\n",
+				),
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.geoJSON.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGeoJSONUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.GeoJSON
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"geojson value is null ": {
+			json: jsontypes.NewGeoJSONNull(),
+			target: struct {
+				Type string `json:"type"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"GeoJSON Unmarshal Error",
+					"geojson string value is null",
+				),
+			},
+		},
+		"geojson value is unknown ": {
+			json: jsontypes.NewGeoJSONUnknown(),
+			target: struct {
+				Type string `json:"type"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"GeoJSON Unmarshal Error",
+					"geojson string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1,2]}`),
+			target: struct {
+				Type string `json:"type"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"GeoJSON Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Type string \"json:\\\"type\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewGeoJSONValue(`{"type":"Point","coordinates":[1.5,2]}`),
+			target: &struct {
+				Type        string    `json:"type"`
+				Coordinates []float64 `json:"coordinates"`
+			}{},
+			output: &struct {
+				Type        string    `json:"type"`
+				Coordinates []float64 `json:"coordinates"`
+			}{
+				Type:        "Point",
+				Coordinates: []float64{1.5, 2},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
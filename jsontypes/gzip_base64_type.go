@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*GzipBase64Type)(nil)
+)
+
+// DefaultMaxDecompressedSize is the maximum size in bytes of the decompressed JSON string of a GzipBase64 value if the
+// GzipBase64Type does not configure MaxDecompressedSize.
+const DefaultMaxDecompressedSize = 10 << 20
+
+// GzipBase64Type is an attribute type that represents a valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON
+// string (RFC 7159). Semantic equality logic is defined for GzipBase64Type such that the decompressed JSON strings are
+// compared like NormalizedType, so differences in compression and encoding, along with inconsequential differences
+// between the JSON strings (whitespace, property order, etc), are ignored.
+//
+// MaxDecompressedSize can be set to limit the size of the decompressed JSON string, which guards against highly compressed
+// values exhausting memory. Types with a different effective MaxDecompressedSize are not equal, where zero, negative and
+// DefaultMaxDecompressedSize are all the same.
+//
+// Values created by the NewGzipBase64Value and other NewGzipBase64 functions have a GzipBase64Type without
+// MaxDecompressedSize. Use the NewValue and other New methods of the type instead for values of a configured type, such
+// as the elements of a collection of that type, as every element must have the element type of the collection.
+type GzipBase64Type struct {
+	basetypes.StringType
+
+	// MaxDecompressedSize is the maximum size in bytes of the decompressed JSON string. Values which decompress to a larger
+	// size are invalid. If zero or negative, DefaultMaxDecompressedSize is used.
+	MaxDecompressedSize int64
+}
+
+// String returns a human readable string of the type name.
+func (t GzipBase64Type) String() string {
+	if size := maxDecompressedSize(t.MaxDecompressedSize); size != DefaultMaxDecompressedSize {
+		return "jsontypes.GzipBase64Type[MaxDecompressedSize: " + strconv.FormatInt(size, 10) + "]"
+	}
+
+	return "jsontypes.GzipBase64Type"
+}
+
+// ValueType returns the Value type.
+func (t GzipBase64Type) ValueType(ctx context.Context) attr.Value {
+	return GzipBase64{
+		maxDecompressedSize: t.MaxDecompressedSize,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t GzipBase64Type) Equal(o attr.Type) bool {
+	other, ok := o.(GzipBase64Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType) && maxDecompressedSize(t.MaxDecompressedSize) == maxDecompressedSize(other.MaxDecompressedSize)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t GzipBase64Type) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return GzipBase64{
+		StringValue:         in,
+		maxDecompressedSize: t.MaxDecompressedSize,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t GzipBase64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// maxDecompressedSize returns the effective maximum decompressed size for the configured size, which is
+// DefaultMaxDecompressedSize if the configured size is not positive.
+func maxDecompressedSize(size int64) int64 {
+	if size <= 0 {
+		return DefaultMaxDecompressedSize
+	}
+
+	return size
+}
+
+// NewNull creates a GzipBase64 with a null value and the MaxDecompressedSize of the type. Determine whether the value
+// is null via IsNull method.
+func (t GzipBase64Type) NewNull() GzipBase64 {
+	return GzipBase64{
+		StringValue:         basetypes.NewStringNull(),
+		maxDecompressedSize: t.MaxDecompressedSize,
+	}
+}
+
+// NewUnknown creates a GzipBase64 with an unknown value and the MaxDecompressedSize of the type. Determine whether the
+// value is unknown via IsUnknown method.
+func (t GzipBase64Type) NewUnknown() GzipBase64 {
+	return GzipBase64{
+		StringValue:         basetypes.NewStringUnknown(),
+		maxDecompressedSize: t.MaxDecompressedSize,
+	}
+}
+
+// NewValue creates a GzipBase64 with a known value and the MaxDecompressedSize of the type. Access the value via
+// ValueString method.
+func (t GzipBase64Type) NewValue(value string) GzipBase64 {
+	return GzipBase64{
+		StringValue:         basetypes.NewStringValue(value),
+		maxDecompressedSize: t.MaxDecompressedSize,
+	}
+}
+
+// NewPointerValue creates a GzipBase64 with a null value if nil or a known value, and the MaxDecompressedSize of the
+// type. Access the value via ValueStringPointer method.
+func (t GzipBase64Type) NewPointerValue(value *string) GzipBase64 {
+	return GzipBase64{
+		StringValue:         basetypes.NewStringPointerValue(value),
+		maxDecompressedSize: t.MaxDecompressedSize,
+	}
+}
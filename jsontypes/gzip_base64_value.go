@@ -0,0 +1,272 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*GzipBase64)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*GzipBase64)(nil)
+	_ xattr.ValidateableAttribute                = (*GzipBase64)(nil)
+	_ function.ValidateableParameter             = (*GzipBase64)(nil)
+)
+
+// GzipBase64 represents a valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string (RFC 7159). Semantic
+// equality logic is defined for GzipBase64 such that the decompressed JSON strings are compared like Normalized, as gzip
+// output can differ between compressions of the same JSON string. Use the DecompressedJSON method to get the decompressed
+// JSON string.
+type GzipBase64 struct {
+	basetypes.StringValue
+
+	// maxDecompressedSize is the MaxDecompressedSize of the GzipBase64Type that created this value.
+	maxDecompressedSize int64
+}
+
+// Type returns a GzipBase64Type.
+func (v GzipBase64) Type(_ context.Context) attr.Type {
+	return GzipBase64Type{
+		MaxDecompressedSize: v.maxDecompressedSize,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v GzipBase64) Equal(o attr.Value) bool {
+	other, ok := o.(GzipBase64)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given gzip compressed JSON string value is semantically equal to the current gzip
+// compressed JSON string value. When compared, both values are decoded and decompressed, and the JSON strings are compared
+// like Normalized. This prevents differences in compression and encoding, along with inconsequential differences in the
+// JSON strings (whitespace, property order, etc), from causing Terraform data consistency errors and resource drift.
+func (v GzipBase64) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(GzipBase64)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := gzipBase64JSONEqual(ctx, v.ValueString(), newValue.ValueString(), v.maxDecompressedSize)
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+func gzipBase64JSONEqual(ctx context.Context, s1, s2 string, maxDecompressedSize int64) (bool, error) {
+	s1, err := decodeGzipBase64JSON(s1, maxDecompressedSize)
+	if err != nil {
+		return false, err
+	}
+
+	s2, err = decodeGzipBase64JSON(s2, maxDecompressedSize)
+	if err != nil {
+		return false, err
+	}
+
+	return (&equalityRules{}).jsonStringsEqual(ctx, s1, s2)
+}
+
+// decodeGzipBase64JSON decodes the base64 string, as with decodeBase64, and decompresses it. An error is returned if the
+// decompressed string is larger than maxSize bytes, or DefaultMaxDecompressedSize if not positive, or if it
+// is not valid JSON. Decompression stops once the limit is exceeded, so the limit also bounds memory usage.
+func decodeGzipBase64JSON(s string, maxSize int64) (string, error) {
+	maxSize = maxDecompressedSize(maxSize)
+
+	compressed, err := decodeBase64(s)
+	if err != nil {
+		return "", err
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", fmt.Errorf("decoded value is not valid gzip: %w", err)
+	}
+
+	// One byte more than the limit is read, so that exceeding the limit can be detected, unless that would overflow.
+	readLimit := maxSize
+	if readLimit < math.MaxInt64 {
+		readLimit++
+	}
+
+	decompressed, err := io.ReadAll(io.LimitReader(reader, readLimit))
+	if err != nil {
+		return "", fmt.Errorf("decoded value is not valid gzip: %w", err)
+	}
+
+	if int64(len(decompressed)) > maxSize {
+		return "", fmt.Errorf("decompressed value exceeds the maximum size of %d bytes", maxSize)
+	}
+
+	var raw json.RawMessage
+
+	if err := json.Unmarshal(decompressed, &raw); err != nil {
+		return "", fmt.Errorf("decompressed value is not valid JSON: %w", err)
+	}
+
+	return string(decompressed), nil
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON format (RFC 7159), which does not exceed
+// the maximum decompressed size.
+func (v GzipBase64) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := decodeGzipBase64JSON(v.ValueString(), v.maxDecompressedSize); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Gzip Base64 JSON String Value",
+			"A string value was provided that is not valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string format (RFC 7159).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON format (RFC 7159),
+// which does not exceed the maximum decompressed size.
+func (v GzipBase64) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := decodeGzipBase64JSON(v.ValueString(), v.maxDecompressedSize); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Gzip Base64 JSON String Value: "+
+				"A string value was provided that is not valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string format (RFC 7159).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// DecompressedJSON returns the decoded and decompressed JSON string of the GzipBase64 StringValue. A null, unknown or invalid
+// value will produce an error diagnostic.
+func (v GzipBase64) DecompressedJSON() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Gzip Base64 JSON Decompression Error", "gzip base64 string value is null"))
+		return "", diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Gzip Base64 JSON Decompression Error", "gzip base64 string value is unknown"))
+		return "", diags
+	}
+
+	jsonStr, err := decodeGzipBase64JSON(v.ValueString(), v.maxDecompressedSize)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Gzip Base64 JSON Decompression Error", err.Error()))
+		return "", diags
+	}
+
+	return jsonStr, diags
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the decompressed JSON string of the GzipBase64 StringValue and `target`
+// input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v GzipBase64) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Gzip Base64 JSON Unmarshal Error", "gzip base64 string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Gzip Base64 JSON Unmarshal Error", "gzip base64 string value is unknown"))
+		return diags
+	}
+
+	jsonStr, err := decodeGzipBase64JSON(v.ValueString(), v.maxDecompressedSize)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Gzip Base64 JSON Unmarshal Error", err.Error()))
+		return diags
+	}
+
+	err = json.Unmarshal([]byte(jsonStr), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Gzip Base64 JSON Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewGzipBase64Null creates a GzipBase64 with a null value. Determine whether the value is null via IsNull method.
+func NewGzipBase64Null() GzipBase64 {
+	return GzipBase64{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewGzipBase64Unknown creates a GzipBase64 with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewGzipBase64Unknown() GzipBase64 {
+	return GzipBase64{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewGzipBase64Value creates a GzipBase64 with a known value, which is expected to be base64 encoded and gzip compressed.
+// Access the value via ValueString method.
+func NewGzipBase64Value(value string) GzipBase64 {
+	return GzipBase64{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewGzipBase64PointerValue creates a GzipBase64 with a null value if nil or a known value, which is expected to be base64
+// encoded and gzip compressed. Access the value via ValueStringPointer method.
+func NewGzipBase64PointerValue(value *string) GzipBase64 {
+	return GzipBase64{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
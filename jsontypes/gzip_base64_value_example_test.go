@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type GzipBase64ResourceModel struct {
+	Json jsontypes.GzipBase64 `tfsdk:"json"`
+}
+
+type GzipBase64Json struct {
+	Hello   string `json:"hello"`
+	Numbers []int  `json:"numbers"`
+}
+
+func ExampleGzipBase64_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := GzipBase64ResourceModel{
+		Json: jsontypes.NewGzipBase64Value("H4sIAAAAAAACA6tWykjNyclXslJQKs8vyklR0lFQyivNTUotKgaKRRvqKBjpKBjH1gIA1R/pJSgAAAA="),
+	}
+
+	// Check that the JSON data is known and able to be unmarshalled
+	if !data.Json.IsNull() && !data.Json.IsUnknown() {
+		var jsonStruct GzipBase64Json
+
+		diags.Append(data.Json.Unmarshal(&jsonStruct)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: {world [1 2 3]}
+		fmt.Printf("%v\n", jsonStruct)
+	}
+}
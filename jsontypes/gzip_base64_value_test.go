@@ -0,0 +1,490 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+// gzipBase64 returns the JSON string gzip compressed at the given level and base64 encoded.
+func gzipBase64(jsonStr string, level int) string {
+	var compressed bytes.Buffer
+
+	writer, err := gzip.NewWriterLevel(&compressed, level)
+	if err != nil {
+		panic(err)
+	}
+
+	// The header differs between compressions, as with many gzip implementations.
+	writer.ModTime = time.Unix(int64(level), 0)
+
+	if _, err := writer.Write([]byte(jsonStr)); err != nil {
+		panic(err)
+	}
+
+	if err := writer.Close(); err != nil {
+		panic(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(compressed.Bytes())
+}
+
+func TestGzipBase64StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		maxDecompressedSize int64
+		currentJson         string
+		givenJson           basetypes.StringValuable
+		expectedMatch       bool
+		expectedDiags       diag.Diagnostics
+	}{
+		"not equal - mismatched field values": {
+			currentJson:   gzipBase64(`{"hello": "world"}`, gzip.DefaultCompression),
+			givenJson:     jsontypes.NewGzipBase64Value(gzipBase64(`{"hello": "dlrow"}`, gzip.DefaultCompression)),
+			expectedMatch: false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentJson:   gzipBase64(`{"hello": "world"}`, gzip.DefaultCompression),
+			givenJson:     jsontypes.NewGzipBase64Value(gzipBase64(`{"hello": "world"}`, gzip.DefaultCompression)),
+			expectedMatch: true,
+		},
+		"semantically equal - compression difference": {
+			currentJson:   gzipBase64(`{"hello": "world", "nums": [1, 2, 3]}`, gzip.BestSpeed),
+			givenJson:     jsontypes.NewGzipBase64Value(gzipBase64(`{"hello": "world", "nums": [1, 2, 3]}`, gzip.BestCompression)),
+			expectedMatch: true,
+		},
+		"semantically equal - json whitespace and field order difference": {
+			currentJson:   gzipBase64("{\n  \"nums\": [1, 2, 3],\n  \"hello\": \"world\"\n}", gzip.NoCompression),
+			givenJson:     jsontypes.NewGzipBase64Value(gzipBase64(`{"hello":"world","nums":[1,2,3]}`, gzip.DefaultCompression)),
+			expectedMatch: true,
+		},
+		"error - exceeds max decompressed size": {
+			maxDecompressedSize: 16,
+			currentJson:         gzipBase64(`{"hello":"world"}`, gzip.DefaultCompression),
+			givenJson:           jsontypes.NewGzipBase64Value(gzipBase64(`{"hello":"world"}`, gzip.DefaultCompression)),
+			expectedMatch:       false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: decompressed value exceeds the maximum size of 16 bytes",
+				),
+			},
+		},
+		"error - not gzip compressed": {
+			currentJson:   gzipBase64(`{"hello":"world"}`, gzip.DefaultCompression),
+			givenJson:     jsontypes.NewGzipBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: decoded value is not valid gzip: gzip: invalid header",
+				),
+			},
+		},
+		"error - not given gzip base64 value": {
+			currentJson:   gzipBase64(`{"hello":"world"}`, gzip.DefaultCompression),
+			givenJson:     basetypes.NewStringValue(gzipBase64(`{"hello":"world"}`, gzip.DefaultCompression)),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.GzipBase64\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typ := jsontypes.GzipBase64Type{MaxDecompressedSize: testCase.maxDecompressedSize}
+
+			valuable, diags := typ.ValueFromString(context.Background(), basetypes.NewStringValue(testCase.currentJson))
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics creating value: %v", diags)
+			}
+
+			currentJson, ok := valuable.(jsontypes.GzipBase64)
+			if !ok {
+				t.Fatalf("Expected jsontypes.GzipBase64, got %T", valuable)
+			}
+
+			match, diags := currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGzipBase64ValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	// bomb is a small compressed value which decompresses to more than the default maximum size.
+	bomb := gzipBase64(`"`+strings.Repeat(" ", jsontypes.DefaultMaxDecompressedSize)+`"`, gzip.BestCompression)
+
+	// truncated is a compressed value which is missing the end of its gzip stream.
+	compressed, _ := base64.StdEncoding.DecodeString(gzipBase64(`{"hello":"world"}`, gzip.DefaultCompression))
+	truncated := base64.StdEncoding.EncodeToString(compressed[:len(compressed)-10])
+
+	testCases := map[string]struct {
+		gzipBase64    jsontypes.GzipBase64
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			gzipBase64: jsontypes.GzipBase64{},
+		},
+		"null": {
+			gzipBase64: jsontypes.NewGzipBase64Null(),
+		},
+		"unknown": {
+			gzipBase64: jsontypes.NewGzipBase64Unknown(),
+		},
+		"valid gzip base64 json": {
+			gzipBase64: jsontypes.NewGzipBase64Value(gzipBase64(`{"hello":"world", "array": [1, 2, 3]}`, gzip.DefaultCompression)),
+		},
+		"invalid base64": {
+			gzipBase64: jsontypes.NewGzipBase64Value(`{"hello":"world"}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Gzip Base64 JSON String Value",
+					"A string value was provided that is not valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string format (RFC 7159).\n\n"+
+						"Error: illegal base64 data at input byte 0\n"+
+						"Given Value: {\"hello\":\"world\"}\n",
+				),
+			},
+		},
+		"invalid gzip - truncated": {
+			gzipBase64: jsontypes.NewGzipBase64Value(truncated),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Gzip Base64 JSON String Value",
+					"A string value was provided that is not valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string format (RFC 7159).\n\n"+
+						"Error: decoded value is not valid gzip: unexpected EOF\n"+
+						"Given Value: "+truncated+"\n",
+				),
+			},
+		},
+		"invalid json - bracket mismatch": {
+			gzipBase64: jsontypes.NewGzipBase64Value(gzipBase64(`{"hello":"world"`, gzip.DefaultCompression)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Gzip Base64 JSON String Value",
+					"A string value was provided that is not valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string format (RFC 7159).\n\n"+
+						"Error: decompressed value is not valid JSON: unexpected end of JSON input\n"+
+						"Given Value: "+gzipBase64(`{"hello":"world"`, gzip.DefaultCompression)+"\n",
+				),
+			},
+		},
+		"invalid size - exceeds default max decompressed size": {
+			gzipBase64: jsontypes.NewGzipBase64Value(bomb),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Gzip Base64 JSON String Value",
+					"A string value was provided that is not valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string format (RFC 7159).\n\n"+
+						"Error: decompressed value exceeds the maximum size of 10485760 bytes\n"+
+						"Given Value: "+bomb+"\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.gzipBase64.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGzipBase64ValidateAttributeMaxDecompressedSize(t *testing.T) {
+	t.Parallel()
+
+	value := gzipBase64(`{"hello":"world"}`, gzip.DefaultCompression)
+
+	testCases := map[string]struct {
+		maxDecompressedSize int64
+		expectedDiags       diag.Diagnostics
+	}{
+		"within max decompressed size": {
+			maxDecompressedSize: 17,
+		},
+		"largest max decompressed size": {
+			maxDecompressedSize: math.MaxInt64,
+		},
+		"exceeds max decompressed size": {
+			maxDecompressedSize: 16,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Gzip Base64 JSON String Value",
+					"A string value was provided that is not valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string format (RFC 7159).\n\n"+
+						"Error: decompressed value exceeds the maximum size of 16 bytes\n"+
+						"Given Value: "+value+"\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typ := jsontypes.GzipBase64Type{MaxDecompressedSize: testCase.maxDecompressedSize}
+
+			valuable, diags := typ.ValueFromString(context.Background(), basetypes.NewStringValue(value))
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics creating value: %v", diags)
+			}
+
+			gzipBase64Value, ok := valuable.(jsontypes.GzipBase64)
+			if !ok {
+				t.Fatalf("Expected jsontypes.GzipBase64, got %T", valuable)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			gzipBase64Value.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGzipBase64ValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		gzipBase64      jsontypes.GzipBase64
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			gzipBase64: jsontypes.GzipBase64{},
+		},
+		"null": {
+			gzipBase64: jsontypes.NewGzipBase64Null(),
+		},
+		"unknown": {
+			gzipBase64: jsontypes.NewGzipBase64Unknown(),
+		},
+		"valid gzip base64 json": {
+			gzipBase64: jsontypes.NewGzipBase64Value(gzipBase64(`{"hello":"world"}`, gzip.DefaultCompression)),
+		},
+		"invalid gzip - base64 json": {
+			gzipBase64: jsontypes.NewGzipBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Gzip Base64 JSON String Value: "+
+					"A string value was provided that is not valid base64 encoded (RFC 4648), gzip compressed (RFC 1952) JSON string format (RFC 7159).\n\n"+
+					"Error: decoded value is not valid gzip: gzip: invalid header\n"+
+					"Given Value: eyJoZWxsbyI6IndvcmxkIn0=\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.gzipBase64.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGzipBase64DecompressedJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		gzipBase64    jsontypes.GzipBase64
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"gzip base64 value is null": {
+			gzipBase64: jsontypes.NewGzipBase64Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Gzip Base64 JSON Decompression Error", "gzip base64 string value is null"),
+			},
+		},
+		"gzip base64 value is unknown": {
+			gzipBase64: jsontypes.NewGzipBase64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Gzip Base64 JSON Decompression Error", "gzip base64 string value is unknown"),
+			},
+		},
+		"invalid gzip": {
+			gzipBase64: jsontypes.NewGzipBase64Value("eyJoZWxsbyI6IndvcmxkIn0="),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Gzip Base64 JSON Decompression Error", "decoded value is not valid gzip: gzip: invalid header"),
+			},
+		},
+		"valid gzip base64 json": {
+			gzipBase64: jsontypes.NewGzipBase64Value(gzipBase64(`{"hello": "world"}`, gzip.BestCompression)),
+			expected:   `{"hello": "world"}`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.gzipBase64.DecompressedJSON()
+
+			if got != testCase.expected {
+				t.Errorf("Expected DecompressedJSON to return: %q, but got: %q", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGzipBase64Unmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.GzipBase64
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"gzip base64 value is null ": {
+			json: jsontypes.NewGzipBase64Null(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Gzip Base64 JSON Unmarshal Error",
+					"gzip base64 string value is null",
+				),
+			},
+		},
+		"gzip base64 value is unknown ": {
+			json: jsontypes.NewGzipBase64Unknown(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Gzip Base64 JSON Unmarshal Error",
+					"gzip base64 string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewGzipBase64Value(gzipBase64(`{"hello": "world"}`, gzip.DefaultCompression)),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Gzip Base64 JSON Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Hello string \"json:\\\"hello\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewGzipBase64Value(gzipBase64(`{"hello": "world", "nums": [1, 2, 3], "test-bool": true}`, gzip.DefaultCompression)),
+			target: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{},
+			output: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{
+				Hello:   "world",
+				Numbers: []int{1, 2, 3},
+				Test:    true,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
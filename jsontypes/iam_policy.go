@@ -0,0 +1,509 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// iamPolicyDefaultVersion is the policy language version used by IAM if a policy has no "Version" element.
+const iamPolicyDefaultVersion = "2008-10-17"
+
+// iamPolicyVersions are the valid values of the "Version" element.
+var iamPolicyVersions = []string{"2008-10-17", "2012-10-17"}
+
+// iamPolicyElements are the valid elements of a policy.
+var iamPolicyElements = []string{"Version", "Id", "Statement"}
+
+// iamStatementElements are the valid elements of a policy statement.
+var iamStatementElements = []string{
+	"Sid", "Effect", "Principal", "NotPrincipal", "Action", "NotAction", "Resource", "NotResource", "Condition",
+}
+
+// iamPrincipalTypes are the valid member names of a "Principal" or "NotPrincipal" object.
+var iamPrincipalTypes = []string{"AWS", "CanonicalUser", "Federated", "Service"}
+
+// iamConditionOperators are the condition operators, without a "ForAllValues:" or "ForAnyValue:" set operator prefix or
+// an "IfExists" suffix.
+var iamConditionOperators = []string{
+	"StringEquals", "StringNotEquals", "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase", "StringLike", "StringNotLike",
+	"NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals", "NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"DateEquals", "DateNotEquals", "DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals",
+	"Bool", "BinaryEquals", "IpAddress", "NotIpAddress",
+	"ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike",
+	"Null",
+}
+
+// iamAccountRootARN matches the ARN of the root user of an AWS account, which IAM uses in place of an account ID principal.
+var iamAccountRootARN = regexp.MustCompile(`^arn:[a-z-]+:iam::(\d{12}):root$`)
+
+// validateIAMPolicy returns an error if the JSON string is not a valid AWS IAM policy document. The policy grammar is
+// validated: the elements of the policy and its statements, the "Effect", exactly one of "Action" or "NotAction", at most
+// one of "Resource" or "NotResource" and of "Principal" or "NotPrincipal", and the operators of the "Condition" block. The
+// error describes the JSON Pointer (RFC 6901) of the offending element.
+func validateIAMPolicy(jsonStr string) error {
+	value, err := decodeValidJSON(jsonStr)
+	if err != nil {
+		return err
+	}
+
+	policy, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("at \"\": expected a policy object, got %s", jsonTypeName(value))
+	}
+
+	if err := validateIAMElements(nil, policy, iamPolicyElements); err != nil {
+		return err
+	}
+
+	if _, ok := policy["Statement"]; !ok {
+		return fmt.Errorf("at \"\": missing required element \"Statement\"")
+	}
+
+	if version, ok := policy["Version"]; ok {
+		if version, ok := version.(string); !ok || !slices.Contains(iamPolicyVersions, version) {
+			return fmt.Errorf("at \"/Version\": expected %q or %q, got %s", iamPolicyVersions[0], iamPolicyVersions[1], iamElementText(policy["Version"]))
+		}
+	}
+
+	if id, ok := policy["Id"]; ok {
+		if _, ok := id.(string); !ok {
+			return fmt.Errorf("at \"/Id\": expected a string, got %s", jsonTypeName(id))
+		}
+	}
+
+	switch statements := policy["Statement"].(type) {
+	case map[string]any:
+		return validateIAMStatement([]string{"Statement"}, statements)
+	case []any:
+		if len(statements) == 0 {
+			return fmt.Errorf("at \"/Statement\": must contain at least one statement")
+		}
+
+		for i, statement := range statements {
+			if err := validateIAMStatement(indexLocation([]string{"Statement"}, i), statement); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("at \"/Statement\": expected an object or array, got %s", jsonTypeName(statements))
+	}
+}
+
+// validateIAMElements returns an error if the object at the given location has a member which is not one of the given
+// element names.
+func validateIAMElements(location []string, object map[string]any, elements []string) error {
+	for _, name := range slices.Sorted(maps.Keys(object)) {
+		if !slices.Contains(elements, name) {
+			return fmt.Errorf("at %q: unknown element %q", formatJSONPointer(childLocation(location, name)), name)
+		}
+	}
+
+	return nil
+}
+
+// validateIAMStatement returns an error if the value at the given location is not a valid policy statement.
+func validateIAMStatement(location []string, value any) error {
+	statement, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("at %q: expected a statement object, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	if err := validateIAMElements(location, statement, iamStatementElements); err != nil {
+		return err
+	}
+
+	if sid, ok := statement["Sid"]; ok {
+		if _, ok := sid.(string); !ok {
+			return fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(childLocation(location, "Sid")), jsonTypeName(sid))
+		}
+	}
+
+	effect, ok := statement["Effect"]
+	if !ok {
+		return fmt.Errorf("at %q: missing required element \"Effect\"", formatJSONPointer(location))
+	}
+
+	if effect != "Allow" && effect != "Deny" {
+		return fmt.Errorf("at %q: expected \"Allow\" or \"Deny\", got %s", formatJSONPointer(childLocation(location, "Effect")), iamElementText(effect))
+	}
+
+	action, err := iamExclusiveElement(location, statement, "Action", "NotAction", true)
+	if err != nil {
+		return err
+	}
+
+	if err := validateIAMStrings(childLocation(location, action), statement[action], validateIAMAction); err != nil {
+		return err
+	}
+
+	resource, err := iamExclusiveElement(location, statement, "Resource", "NotResource", false)
+	if err != nil {
+		return err
+	}
+
+	if resource != "" {
+		if err := validateIAMStrings(childLocation(location, resource), statement[resource], nil); err != nil {
+			return err
+		}
+	}
+
+	principal, err := iamExclusiveElement(location, statement, "Principal", "NotPrincipal", false)
+	if err != nil {
+		return err
+	}
+
+	if principal != "" {
+		if err := validateIAMPrincipal(childLocation(location, principal), statement[principal]); err != nil {
+			return err
+		}
+	}
+
+	if condition, ok := statement["Condition"]; ok {
+		return validateIAMCondition(childLocation(location, "Condition"), condition)
+	}
+
+	return nil
+}
+
+// iamExclusiveElement returns the name of whichever of the two mutually exclusive elements is in the statement at the
+// given location, or an empty string if neither is and neither is required. An error is returned if both are present.
+func iamExclusiveElement(location []string, statement map[string]any, name, notName string, required bool) (string, error) {
+	_, ok := statement[name]
+	_, notOk := statement[notName]
+
+	switch {
+	case ok && notOk:
+		return "", fmt.Errorf("at %q: only one of %q or %q may be specified", formatJSONPointer(location), name, notName)
+	case ok:
+		return name, nil
+	case notOk:
+		return notName, nil
+	case required:
+		return "", fmt.Errorf("at %q: missing required element %q or %q", formatJSONPointer(location), name, notName)
+	default:
+		return "", nil
+	}
+}
+
+// validateIAMStrings returns an error if the value at the given location is not a string or a non-empty array of strings.
+// If validateString is not nil, it is called for each string.
+func validateIAMStrings(location []string, value any, validateString func(s string) error) error {
+	var elements []any
+
+	switch value := value.(type) {
+	case string:
+		if validateString != nil {
+			if err := validateString(value); err != nil {
+				return fmt.Errorf("at %q: %w", formatJSONPointer(location), err)
+			}
+		}
+
+		return nil
+	case []any:
+		elements = value
+	default:
+		return fmt.Errorf("at %q: expected a string or array of strings, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	if len(elements) == 0 {
+		return fmt.Errorf("at %q: must not be empty", formatJSONPointer(location))
+	}
+
+	for i, element := range elements {
+		s, ok := element.(string)
+		if !ok {
+			return fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(indexLocation(location, i)), jsonTypeName(element))
+		}
+
+		if validateString != nil {
+			if err := validateString(s); err != nil {
+				return fmt.Errorf("at %q: %w", formatJSONPointer(indexLocation(location, i)), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateIAMAction returns an error if the action is not "*" or of the form "service:action", where the action may
+// contain wildcards.
+func validateIAMAction(action string) error {
+	if action == "*" {
+		return nil
+	}
+
+	service, name, ok := strings.Cut(action, ":")
+	if !ok || service == "" || name == "" {
+		return fmt.Errorf("action %q must be \"*\" or of the form \"service:action\"", action)
+	}
+
+	return nil
+}
+
+// validateIAMPrincipal returns an error if the value at the given location is not "*" or an object of principal types,
+// each of which is a string or array of strings.
+func validateIAMPrincipal(location []string, value any) error {
+	if value == "*" {
+		return nil
+	}
+
+	principals, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("at %q: expected \"*\" or an object, got %s", formatJSONPointer(location), iamElementText(value))
+	}
+
+	if len(principals) == 0 {
+		return fmt.Errorf("at %q: must not be empty", formatJSONPointer(location))
+	}
+
+	for _, principalType := range slices.Sorted(maps.Keys(principals)) {
+		if !slices.Contains(iamPrincipalTypes, principalType) {
+			return fmt.Errorf("at %q: unknown principal type %q", formatJSONPointer(childLocation(location, principalType)), principalType)
+		}
+
+		if err := validateIAMStrings(childLocation(location, principalType), principals[principalType], nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateIAMCondition returns an error if the value at the given location is not a condition block, which is an object of
+// condition operators, each of which is an object of condition keys with a scalar or array of scalar values.
+func validateIAMCondition(location []string, value any) error {
+	operators, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("at %q: expected an object, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	for _, operator := range slices.Sorted(maps.Keys(operators)) {
+		operatorLocation := childLocation(location, operator)
+
+		if !validIAMConditionOperator(operator) {
+			return fmt.Errorf("at %q: unknown condition operator %q", formatJSONPointer(operatorLocation), operator)
+		}
+
+		keys, ok := operators[operator].(map[string]any)
+		if !ok {
+			return fmt.Errorf("at %q: expected an object, got %s", formatJSONPointer(operatorLocation), jsonTypeName(operators[operator]))
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(keys)) {
+			keyLocation := childLocation(operatorLocation, key)
+
+			values, ok := keys[key].([]any)
+			if !ok {
+				values = []any{keys[key]}
+			} else if len(values) == 0 {
+				return fmt.Errorf("at %q: must not be empty", formatJSONPointer(keyLocation))
+			}
+
+			for i, conditionValue := range values {
+				switch conditionValue.(type) {
+				case string, json.Number, bool:
+				default:
+					valueLocation := keyLocation
+
+					if _, ok := keys[key].([]any); ok {
+						valueLocation = indexLocation(keyLocation, i)
+					}
+
+					return fmt.Errorf("at %q: expected a string, number or boolean, got %s", formatJSONPointer(valueLocation), jsonTypeName(conditionValue))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validIAMConditionOperator returns true if the condition operator is known, along with any set operator prefix and
+// "IfExists" suffix. The Null operator cannot have the "IfExists" suffix.
+func validIAMConditionOperator(operator string) bool {
+	if prefix, rest, ok := strings.Cut(operator, ":"); ok {
+		if prefix != "ForAllValues" && prefix != "ForAnyValue" {
+			return false
+		}
+
+		operator = rest
+	}
+
+	if base, ok := strings.CutSuffix(operator, "IfExists"); ok && base != "Null" {
+		operator = base
+	}
+
+	return slices.Contains(iamConditionOperators, operator)
+}
+
+// iamElementText returns the element for use in error messages: strings are quoted, while other values are described by
+// their JSON type.
+func iamElementText(value any) string {
+	if s, ok := value.(string); ok {
+		return strconv.Quote(s)
+	}
+
+	return jsonTypeName(value)
+}
+
+// iamPolicyEqual returns true if both IAM policy JSON strings are semantically equal. Both policies are normalized to
+// account for the rewrites IAM makes, then compared like Normalized with statements in any order.
+func iamPolicyEqual(ctx context.Context, s1, s2 string) (bool, error) {
+	priorValue, err := decodeJSON(s1)
+	if err != nil {
+		return false, err
+	}
+
+	newValue, err := decodeJSON(s2)
+	if err != nil {
+		return false, err
+	}
+
+	r := &equalityRules{
+		unorderedArrayPaths: []jsonPointer{{"Statement"}},
+	}
+
+	return r.valuesEqual(ctx, normalizeIAMPolicy(priorValue), normalizeIAMPolicy(newValue)), nil
+}
+
+// normalizeIAMPolicy returns the decoded policy with the equivalent forms which IAM may return replaced with a single form.
+// A missing "Version" is set to the default version, a single statement object is replaced with an array, and elements
+// which are a string or a set of strings are replaced with sorted arrays without duplicates. Condition values are
+// compared as strings, the "*" principal is replaced with {"AWS":"*"} and account root user ARN principals are replaced
+// with the account ID. The decoded value is modified in place.
+func normalizeIAMPolicy(value any) any {
+	policy, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+
+	if _, ok := policy["Version"]; !ok {
+		policy["Version"] = iamPolicyDefaultVersion
+	}
+
+	var statements []any
+
+	switch statement := policy["Statement"].(type) {
+	case []any:
+		statements = statement
+	case map[string]any:
+		statements = []any{statement}
+		policy["Statement"] = statements
+	}
+
+	for _, statement := range statements {
+		statement, ok := statement.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, name := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+			if element, ok := statement[name]; ok {
+				statement[name] = iamStringSet(element, nil)
+			}
+		}
+
+		for _, name := range []string{"Principal", "NotPrincipal"} {
+			// IAM treats the anonymous principal "*" the same as {"AWS":"*"}.
+			if statement[name] == "*" {
+				statement[name] = map[string]any{"AWS": "*"}
+			}
+
+			principals, ok := statement[name].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			for principalType, principal := range principals {
+				if principalType == "AWS" {
+					principals[principalType] = iamStringSet(principal, normalizeIAMAccountPrincipal)
+				} else {
+					principals[principalType] = iamStringSet(principal, nil)
+				}
+			}
+		}
+
+		operators, ok := statement["Condition"].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, keys := range operators {
+			keys, ok := keys.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			for key, values := range keys {
+				keys[key] = iamStringSet(values, nil)
+			}
+		}
+	}
+
+	return policy
+}
+
+// iamStringSet returns a scalar or array of scalars as a sorted array of strings without duplicates, as IAM treats such
+// elements as sets and returns single element sets as a scalar. Numbers and booleans are converted to strings. If
+// normalize is not nil, it is applied to each string. Other values are returned as is.
+func iamStringSet(value any, normalize func(s string) string) any {
+	elements, ok := value.([]any)
+	if !ok {
+		elements = []any{value}
+	}
+
+	set := make([]string, 0, len(elements))
+
+	for _, element := range elements {
+		var s string
+
+		switch element := element.(type) {
+		case string:
+			s = element
+		case json.Number:
+			s = element.String()
+		case bool:
+			s = strconv.FormatBool(element)
+		default:
+			return value
+		}
+
+		if normalize != nil {
+			s = normalize(s)
+		}
+
+		set = append(set, s)
+	}
+
+	slices.Sort(set)
+
+	normalized := make([]any, 0, len(set))
+
+	for _, s := range slices.Compact(set) {
+		normalized = append(normalized, s)
+	}
+
+	return normalized
+}
+
+// normalizeIAMAccountPrincipal returns the account ID of an account root user ARN, as IAM replaces account ID principals
+// with the ARN of the account root user.
+func normalizeIAMAccountPrincipal(principal string) string {
+	if match := iamAccountRootARN.FindStringSubmatch(principal); match != nil {
+		return match[1]
+	}
+
+	return principal
+}
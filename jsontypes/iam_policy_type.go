@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*IAMPolicyType)(nil)
+)
+
+// IAMPolicyType is an attribute type that represents a valid AWS IAM policy document, which is a JSON string (RFC 7159)
+// following the IAM policy grammar. Semantic equality logic is defined for IAMPolicyType such that the rewrites IAM makes
+// to stored policies, such as replacing single element arrays with strings and reordering statements, are ignored along
+// with other inconsequential differences between JSON strings (whitespace, property order, etc), like NormalizedType.
+type IAMPolicyType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IAMPolicyType) String() string {
+	return "jsontypes.IAMPolicyType"
+}
+
+// ValueType returns the Value type.
+func (t IAMPolicyType) ValueType(ctx context.Context) attr.Value {
+	return IAMPolicy{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IAMPolicyType) Equal(o attr.Type) bool {
+	other, ok := o.(IAMPolicyType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IAMPolicyType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IAMPolicy{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t IAMPolicyType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*IAMPolicy)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IAMPolicy)(nil)
+	_ xattr.ValidateableAttribute                = (*IAMPolicy)(nil)
+	_ function.ValidateableParameter             = (*IAMPolicy)(nil)
+)
+
+// IAMPolicy represents a valid AWS IAM policy document, such as an identity-based, resource-based or trust policy.
+// Semantic equality logic is defined for IAMPolicy such that the equivalent forms which IAM returns for a stored policy
+// do not cause differences, along with other inconsequential differences between JSON strings (whitespace, property
+// order, etc), like Normalized.
+type IAMPolicy struct {
+	basetypes.StringValue
+}
+
+// Type returns an IAMPolicyType.
+func (v IAMPolicy) Type(_ context.Context) attr.Type {
+	return IAMPolicyType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IAMPolicy) Equal(o attr.Value) bool {
+	other, ok := o.(IAMPolicy)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given IAM policy string value is semantically equal to the current IAM policy
+// string value. When compared, the policies are compared like Normalized after accounting for the rewrites IAM makes: a
+// missing "Version" equals the default version "2008-10-17", statements are compared in any order and a single statement
+// object equals an array of that statement. Action, resource, principal and condition values are compared as sets, so a
+// single element array equals a string, and condition values which are numbers or booleans equal the same value as a
+// string. The "*" principal equals {"AWS":"*"}, and an AWS account ID principal equals the ARN of the account root user,
+// such as "arn:aws:iam::123456789012:root".
+func (v IAMPolicy) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IAMPolicy)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := iamPolicyEqual(ctx, v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid AWS IAM policy document.
+func (v IAMPolicy) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateIAMPolicy(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IAM Policy String Value",
+			"A string value was provided that is not valid AWS IAM policy document string format.\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid AWS IAM policy document.
+func (v IAMPolicy) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateIAMPolicy(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid IAM Policy String Value: "+
+				"A string value was provided that is not valid AWS IAM policy document string format.\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the IAMPolicy StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v IAMPolicy) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("IAM Policy Unmarshal Error", "iam policy string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("IAM Policy Unmarshal Error", "iam policy string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("IAM Policy Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewIAMPolicyNull creates an IAMPolicy with a null value. Determine whether the value is null via IsNull method.
+func NewIAMPolicyNull() IAMPolicy {
+	return IAMPolicy{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIAMPolicyUnknown creates an IAMPolicy with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewIAMPolicyUnknown() IAMPolicy {
+	return IAMPolicy{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIAMPolicyValue creates an IAMPolicy with a known value. Access the value via ValueString method.
+func NewIAMPolicyValue(value string) IAMPolicy {
+	return IAMPolicy{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIAMPolicyPointerValue creates an IAMPolicy with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewIAMPolicyPointerValue(value *string) IAMPolicy {
+	return IAMPolicy{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type IAMPolicyResourceModel struct {
+	Policy jsontypes.IAMPolicy `tfsdk:"policy"`
+}
+
+type IAMPolicyDocument struct {
+	Version   string `json:"Version"`
+	Statement []struct {
+		Effect string `json:"Effect"`
+	} `json:"Statement"`
+}
+
+func ExampleIAMPolicy_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := IAMPolicyResourceModel{
+		Policy: jsontypes.NewIAMPolicyValue(`{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}]}`),
+	}
+
+	// Check that the policy data is known and able to be unmarshalled
+	if !data.Policy.IsNull() && !data.Policy.IsUnknown() {
+		var policy IAMPolicyDocument
+
+		diags.Append(data.Policy.Unmarshal(&policy)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: 2012-10-17 Allow
+		fmt.Println(policy.Version, policy.Statement[0].Effect)
+	}
+}
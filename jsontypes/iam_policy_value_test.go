@@ -0,0 +1,432 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestIAMPolicyStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.IAMPolicy
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - single element arrays and scalars": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["*"]}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - statement order": {
+			currentJson: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},` +
+				`{"Sid":"Write","Effect":"Deny","Action":"s3:PutObject","Resource":"*"}]}`),
+			givenJson: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[` +
+				`{"Sid":"Write","Effect":"Deny","Action":"s3:PutObject","Resource":"*"},` +
+				`{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - action and resource order and duplicates": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject","s3:GetObject"],"Resource":["arn:aws:s3:::b/*","arn:aws:s3:::a/*"]}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"]}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - default version": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - principal order and account root arn": {
+			currentJson: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole",` +
+				`"Principal":{"AWS":["123456789012","arn:aws:iam::210987654321:role/admin"],"Service":["lambda.amazonaws.com"]}}]}`),
+			givenJson: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole",` +
+				`"Principal":{"Service":"lambda.amazonaws.com","AWS":["arn:aws:iam::210987654321:role/admin","arn:aws:iam::123456789012:root"]}}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - condition values": {
+			currentJson: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*",` +
+				`"Condition":{"Bool":{"aws:SecureTransport":false},"StringEquals":{"aws:PrincipalTag/team":["b","a"]},"NumericLessThan":{"s3:TlsVersion":1.2}}}]}`),
+			givenJson: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*",` +
+				`"Condition":{"Bool":{"aws:SecureTransport":"false"},"StringEquals":{"aws:PrincipalTag/team":["a","b"]},"NumericLessThan":{"s3:TlsVersion":["1.2"]}}}]}`),
+			expectedMatch: true,
+		},
+		"not equal - different version": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage","Resource":"*"}]}`),
+			expectedMatch: false,
+		},
+		"not equal - different effect": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`),
+			expectedMatch: false,
+		},
+		"not equal - action and not action": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","NotAction":"s3:GetObject","Resource":"*"}]}`),
+			expectedMatch: false,
+		},
+		"not equal - additional statement": {
+			currentJson: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			givenJson: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"},` +
+				`{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`),
+			expectedMatch: false,
+		},
+		"semantically equal - anonymous principal forms": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"AWS":["*"]}}]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - anonymous not principal forms": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*","NotPrincipal":{"AWS":"*"}}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*","NotPrincipal":"*"}]}`),
+			expectedMatch: true,
+		},
+		"not equal - anonymous principal and service principal": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":"*"}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Principal":{"Service":"*"}}]}`),
+			expectedMatch: false,
+		},
+		"not equal - account id in different principal type": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Federated":"123456789012"}}]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Federated":"arn:aws:iam::123456789012:root"}}]}`),
+			expectedMatch: false,
+		},
+		"error - invalid json": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Statement":[]}`),
+			givenJson:     jsontypes.NewIAMPolicyValue(`{"Statement":[`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+		"error - not given iam policy value": {
+			currentJson:   jsontypes.NewIAMPolicyValue(`{"Statement":[]}`),
+			givenJson:     basetypes.NewStringValue(`{"Statement":[]}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.IAMPolicy\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy        jsontypes.IAMPolicy
+		expectedError string
+	}{
+		"empty-struct": {
+			policy: jsontypes.IAMPolicy{},
+		},
+		"null": {
+			policy: jsontypes.NewIAMPolicyNull(),
+		},
+		"unknown": {
+			policy: jsontypes.NewIAMPolicyUnknown(),
+		},
+		"valid identity policy": {
+			policy: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Id":"example","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:Get*","s3:List*"],"Resource":"*",` +
+				`"Condition":{"ForAnyValue:StringLikeIfExists":{"aws:TagKeys":["team*"]},"Null":{"aws:TokenIssueTime":true},"NumericLessThan":{"s3:TlsVersion":1.2}}}]}`),
+		},
+		"valid trust policy": {
+			policy: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":["ec2.amazonaws.com"]}}}`),
+		},
+		"valid resource policy": {
+			policy: jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Deny","Principal":"*","NotAction":"s3:GetObject","NotResource":"arn:aws:s3:::bucket/public/*"}]}`),
+		},
+		"invalid json": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":`),
+			expectedError: "unexpected end of JSON input",
+		},
+		"invalid policy - not an object": {
+			policy:        jsontypes.NewIAMPolicyValue(`[]`),
+			expectedError: `at "": expected a policy object, got array`,
+		},
+		"invalid policy - unknown element": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[],"Statements":[]}`),
+			expectedError: `at "/Statements": unknown element "Statements"`,
+		},
+		"invalid policy - missing statement": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17"}`),
+			expectedError: `at "": missing required element "Statement"`,
+		},
+		"invalid policy - empty statement": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Statement":[]}`),
+			expectedError: `at "/Statement": must contain at least one statement`,
+		},
+		"invalid policy - version": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-18","Statement":{"Effect":"Allow","Action":"*"}}`),
+			expectedError: `at "/Version": expected "2008-10-17" or "2012-10-17", got "2012-10-18"`,
+		},
+		"invalid statement - effect": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*"},{"Effect":"allow","Action":"*"}]}`),
+			expectedError: `at "/Statement/1/Effect": expected "Allow" or "Deny", got "allow"`,
+		},
+		"invalid statement - missing effect": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":{"Action":"*"}}`),
+			expectedError: `at "/Statement": missing required element "Effect"`,
+		},
+		"invalid statement - unknown element": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Actions":"*"}]}`),
+			expectedError: `at "/Statement/0/Actions": unknown element "Actions"`,
+		},
+		"invalid statement - missing action": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Resource":"*"}]}`),
+			expectedError: `at "/Statement/0": missing required element "Action" or "NotAction"`,
+		},
+		"invalid statement - action and not action": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","NotAction":"s3:*"}]}`),
+			expectedError: `at "/Statement/0": only one of "Action" or "NotAction" may be specified`,
+		},
+		"invalid statement - resource and not resource": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","NotResource":"*"}]}`),
+			expectedError: `at "/Statement/0": only one of "Resource" or "NotResource" may be specified`,
+		},
+		"invalid statement - action format": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":["s3:GetObject","GetObject"]}]}`),
+			expectedError: `at "/Statement/0/Action/1": action "GetObject" must be "*" or of the form "service:action"`,
+		},
+		"invalid statement - empty resource": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","Resource":[]}]}`),
+			expectedError: `at "/Statement/0/Resource": must not be empty`,
+		},
+		"invalid statement - resource not a string": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","NotResource":[1]}]}`),
+			expectedError: `at "/Statement/0/NotResource/0": expected a string, got number`,
+		},
+		"invalid principal - string other than wildcard": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","Principal":"123456789012"}]}`),
+			expectedError: `at "/Statement/0/Principal": expected "*" or an object, got "123456789012"`,
+		},
+		"invalid principal - unknown principal type": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","NotPrincipal":{"User":"alice"}}]}`),
+			expectedError: `at "/Statement/0/NotPrincipal/User": unknown principal type "User"`,
+		},
+		"invalid condition - unknown operator": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","Condition":{"StringEqual":{"aws:username":"alice"}}}]}`),
+			expectedError: `at "/Statement/0/Condition/StringEqual": unknown condition operator "StringEqual"`,
+		},
+		"invalid condition - null if exists": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","Condition":{"NullIfExists":{"aws:username":"true"}}}]}`),
+			expectedError: `at "/Statement/0/Condition/NullIfExists": unknown condition operator "NullIfExists"`,
+		},
+		"invalid condition - unknown set operator": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","Condition":{"ForEachValue:StringEquals":{"aws:TagKeys":"team"}}}]}`),
+			expectedError: `at "/Statement/0/Condition/ForEachValue:StringEquals": unknown condition operator "ForEachValue:StringEquals"`,
+		},
+		"invalid condition - value": {
+			policy:        jsontypes.NewIAMPolicyValue(`{"Statement":[{"Effect":"Allow","Action":"*","Condition":{"StringEquals":{"aws:username":["alice",{}]}}}]}`),
+			expectedError: `at "/Statement/0/Condition/StringEquals/aws:username/1": expected a string, number or boolean, got object`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid IAM Policy String Value",
+					"A string value was provided that is not valid AWS IAM policy document string format.\n\n"+
+						"Error: "+testCase.expectedError+"\n"+
+						"Given Value: "+testCase.policy.ValueString()+"\n",
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.policy.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy          jsontypes.IAMPolicy
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			policy: jsontypes.IAMPolicy{},
+		},
+		"null": {
+			policy: jsontypes.NewIAMPolicyNull(),
+		},
+		"unknown": {
+			policy: jsontypes.NewIAMPolicyUnknown(),
+		},
+		"valid policy": {
+			policy: jsontypes.NewIAMPolicyValue(`{"Statement":{"Effect":"Allow","Action":"*","Resource":"*"}}`),
+		},
+		"invalid policy - effect": {
+			policy: jsontypes.NewIAMPolicyValue(`{"Statement":{"Effect":true,"Action":"*"}}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid IAM Policy String Value: "+
+					"A string value was provided that is not valid AWS IAM policy document string format.\n\n"+
+					"Error: at \"/Statement/Effect\": expected \"Allow\" or \"Deny\", got boolean\n"+
+					"Given Value: {\"Statement\":{\"Effect\":true,\"Action\":\"*\"}}\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.policy.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.IAMPolicy
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"iam policy value is null ": {
+			json: jsontypes.NewIAMPolicyNull(),
+			target: struct {
+				Version string `json:"Version"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IAM Policy Unmarshal Error",
+					"iam policy string value is null",
+				),
+			},
+		},
+		"iam policy value is unknown ": {
+			json: jsontypes.NewIAMPolicyUnknown(),
+			target: struct {
+				Version string `json:"Version"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IAM Policy Unmarshal Error",
+					"iam policy string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17"}`),
+			target: struct {
+				Version string `json:"Version"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"IAM Policy Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Version string \"json:\\\"Version\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewIAMPolicyValue(`{"Version":"2012-10-17","Id":"example"}`),
+			target: &struct {
+				Version string `json:"Version"`
+				Id      string `json:"Id"`
+			}{},
+			output: &struct {
+				Version string `json:"Version"`
+				Id      string `json:"Id"`
+			}{
+				Version: "2012-10-17",
+				Id:      "example",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+)
+
+// maxSafeInteger is the largest integer n such that IEEE 754 double precision can exactly represent every integer from -n
+// to n, which is (2^53)-1.
+var maxSafeInteger = big.NewInt(1<<53 - 1)
+
+// validateIJSON returns an error if the JSON string is not valid I-JSON (RFC 7493). In addition to being valid JSON, object
+// member names must be unique, strings must not contain lone surrogates or invalid UTF-8, integers must be within the range
+// -(2^53)+1 to (2^53)-1 and other numbers must be within the range of IEEE 754 double precision. The error describes the
+// JSON Pointer (RFC 6901) of the offending value.
+func validateIJSON(jsonStr string) error {
+	value, err := decodeStrictJSON(jsonStr)
+	if err != nil {
+		return err
+	}
+
+	return validateIJSONNumbers(nil, value)
+}
+
+// validateIJSONNumbers returns an error for the first number in the decoded value which is out of the I-JSON range. Object
+// members are checked in order of their names, so the error is deterministic.
+func validateIJSONNumbers(location []string, value any) error {
+	switch value := value.(type) {
+	case map[string]any:
+		for _, name := range slices.Sorted(maps.Keys(value)) {
+			if err := validateIJSONNumbers(childLocation(location, name), value[name]); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for i, element := range value {
+			if err := validateIJSONNumbers(indexLocation(location, i), element); err != nil {
+				return err
+			}
+		}
+	case json.Number:
+		if isInteger(value.String()) {
+			integer, ok := new(big.Int).SetString(value.String(), 10)
+			if !ok || integer.CmpAbs(maxSafeInteger) > 0 {
+				return fmt.Errorf("at %q: integer %s is outside the range -(2^53)+1 to (2^53)-1", formatJSONPointer(location), value)
+			}
+
+			return nil
+		}
+
+		if f, err := strconv.ParseFloat(value.String(), 64); err != nil || math.IsInf(f, 0) {
+			return fmt.Errorf("at %q: number %s is out of the range of IEEE 754 double precision", formatJSONPointer(location), value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*IJSONType)(nil)
+)
+
+// IJSONType is an attribute type that represents a valid I-JSON string (RFC 7493), which is a JSON string (RFC 7159) with
+// unique object member names, no lone surrogates or invalid UTF-8 in strings, integers within the range -(2^53)+1 to (2^53)-1
+// and other numbers within the range of IEEE 754 double precision. Semantic equality logic is defined for IJSONType such that
+// inconsequential differences between JSON strings are ignored (whitespace, property order, etc), like NormalizedType.
+type IJSONType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t IJSONType) String() string {
+	return "jsontypes.IJSONType"
+}
+
+// ValueType returns the Value type.
+func (t IJSONType) ValueType(ctx context.Context) attr.Value {
+	return IJSON{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t IJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(IJSONType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t IJSONType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IJSON{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t IJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
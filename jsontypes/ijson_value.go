@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*IJSON)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*IJSON)(nil)
+	_ xattr.ValidateableAttribute                = (*IJSON)(nil)
+	_ function.ValidateableParameter             = (*IJSON)(nil)
+)
+
+// IJSON represents a valid I-JSON string (RFC 7493), which is a JSON string (RFC 7159) without the constructs that
+// commonly cause interoperability problems: duplicate object member names, lone surrogates or invalid UTF-8 in strings,
+// integers beyond the range that IEEE 754 double precision can represent exactly and numbers beyond its range. Semantic
+// equality logic is defined for IJSON such that inconsequential differences between JSON strings are ignored (whitespace,
+// property order, etc), like Normalized.
+type IJSON struct {
+	basetypes.StringValue
+}
+
+// Type returns an IJSONType.
+func (v IJSON) Type(_ context.Context) attr.Type {
+	return IJSONType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v IJSON) Equal(o attr.Value) bool {
+	other, ok := o.(IJSON)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given JSON string value is semantically equal to the current JSON string value.
+// When compared, the JSON string values are compared like Normalized, which ignores inconsequential differences in the JSON
+// strings (whitespace, property order, etc).
+func (v IJSON) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IJSON)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := (&equalityRules{}).jsonStringsEqual(ctx, v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid I-JSON format (RFC 7493).
+func (v IJSON) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateIJSON(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid I-JSON String Value",
+			"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid I-JSON format (RFC 7493).
+func (v IJSON) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if err := validateIJSON(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid I-JSON String Value: "+
+				"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the IJSON StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v IJSON) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("I-JSON Unmarshal Error", "json string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("I-JSON Unmarshal Error", "json string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("I-JSON Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewIJSONNull creates an IJSON with a null value. Determine whether the value is null via IsNull method.
+func NewIJSONNull() IJSON {
+	return IJSON{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewIJSONUnknown creates an IJSON with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewIJSONUnknown() IJSON {
+	return IJSON{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewIJSONValue creates an IJSON with a known value. Access the value via ValueString method.
+func NewIJSONValue(value string) IJSON {
+	return IJSON{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewIJSONPointerValue creates an IJSON with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewIJSONPointerValue(value *string) IJSON {
+	return IJSON{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,357 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestIJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.IJSON
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not equal - mismatched field values": {
+			currentJson:   jsontypes.NewIJSONValue(`{"hello": "dlrow", "nums": [3, 2, 1]}`),
+			givenJson:     jsontypes.NewIJSONValue(`{"hello": "world", "nums": [1, 2, 3]}`),
+			expectedMatch: false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentJson:   jsontypes.NewIJSONValue(`{"hello": "world", "nums": [1, 2, 3]}`),
+			givenJson:     jsontypes.NewIJSONValue(`{"hello": "world", "nums": [1, 2, 3]}`),
+			expectedMatch: true,
+		},
+		"semantically equal - whitespace and field order difference": {
+			currentJson:   jsontypes.NewIJSONValue("{\n  \"nums\": [1, 2, 3],\n  \"hello\": \"world\"\n}"),
+			givenJson:     jsontypes.NewIJSONValue(`{"hello":"world","nums":[1,2,3]}`),
+			expectedMatch: true,
+		},
+		"error - invalid json": {
+			currentJson:   jsontypes.NewIJSONValue(`{"hello": "world"}`),
+			givenJson:     jsontypes.NewIJSONValue(`{"hello": "world"`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+		"error - not given ijson value": {
+			currentJson:   jsontypes.NewIJSONValue(`{"hello": "world"}`),
+			givenJson:     basetypes.NewStringValue(`{"hello": "world"}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.IJSON\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIJSONValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ijson         jsontypes.IJSON
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			ijson: jsontypes.IJSON{},
+		},
+		"null": {
+			ijson: jsontypes.NewIJSONNull(),
+		},
+		"unknown": {
+			ijson: jsontypes.NewIJSONUnknown(),
+		},
+		"valid json object": {
+			ijson: jsontypes.NewIJSONValue(`{"hello":"world", "array": [1, 2, 3]}`),
+		},
+		"valid i-json - safe integer bounds": {
+			ijson: jsontypes.NewIJSONValue(`[9007199254740991, -9007199254740991, 1e300, 0.1]`),
+		},
+		"valid i-json - surrogate pair": {
+			ijson: jsontypes.NewIJSONValue(`{"emoji": "\ud83d\ude00"}`),
+		},
+		"invalid json - bracket mismatch": {
+			ijson: jsontypes.NewIJSONValue(`{"hello":"world"`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid I-JSON String Value",
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+						"Error: unexpected end of JSON input\n"+
+						"Given Value: {\"hello\":\"world\"\n",
+				),
+			},
+		},
+		"invalid i-json - duplicate object member name": {
+			ijson: jsontypes.NewIJSONValue(`{"tags": {"env": "prod", "env": "dev"}}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid I-JSON String Value",
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+						"Error: at \"/tags/env\": duplicate object member name \"env\"\n"+
+						"Given Value: {\"tags\": {\"env\": \"prod\", \"env\": \"dev\"}}\n",
+				),
+			},
+		},
+		"invalid i-json - lone surrogate": {
+			ijson: jsontypes.NewIJSONValue(`{"names": ["\udead"]}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid I-JSON String Value",
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+						"Error: at \"/names/0\": lone surrogate \\udead\n"+
+						"Given Value: {\"names\": [\"\\udead\"]}\n",
+				),
+			},
+		},
+		"invalid i-json - integer beyond safe range": {
+			ijson: jsontypes.NewIJSONValue(`{"id": 9007199254740993}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid I-JSON String Value",
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+						"Error: at \"/id\": integer 9007199254740993 is outside the range -(2^53)+1 to (2^53)-1\n"+
+						"Given Value: {\"id\": 9007199254740993}\n",
+				),
+			},
+		},
+		"invalid i-json - negative integer beyond safe range": {
+			ijson: jsontypes.NewIJSONValue(`[-9007199254740992]`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid I-JSON String Value",
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+						"Error: at \"/0\": integer -9007199254740992 is outside the range -(2^53)+1 to (2^53)-1\n"+
+						"Given Value: [-9007199254740992]\n",
+				),
+			},
+		},
+		"invalid i-json - number beyond double precision": {
+			ijson: jsontypes.NewIJSONValue(`{"ratio": 1.5e999}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid I-JSON String Value",
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+						"Error: at \"/ratio\": number 1.5e999 is out of the range of IEEE 754 double precision\n"+
+						"Given Value: {\"ratio\": 1.5e999}\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.ijson.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIJSONValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ijson           jsontypes.IJSON
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			ijson: jsontypes.IJSON{},
+		},
+		"null": {
+			ijson: jsontypes.NewIJSONNull(),
+		},
+		"unknown": {
+			ijson: jsontypes.NewIJSONUnknown(),
+		},
+		"valid json object": {
+			ijson: jsontypes.NewIJSONValue(`{"hello":"world", "array": [1, 2, 3]}`),
+		},
+		"invalid i-json - duplicate object member name": {
+			ijson: jsontypes.NewIJSONValue(`{"tags": {"env": "prod", "env": "dev"}}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid I-JSON String Value: "+
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+					"Error: at \"/tags/env\": duplicate object member name \"env\"\n"+
+					"Given Value: {\"tags\": {\"env\": \"prod\", \"env\": \"dev\"}}\n",
+			),
+		},
+		"invalid i-json - lone surrogate": {
+			ijson: jsontypes.NewIJSONValue(`{"names": ["\udead"]}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid I-JSON String Value: "+
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+					"Error: at \"/names/0\": lone surrogate \\udead\n"+
+					"Given Value: {\"names\": [\"\\udead\"]}\n",
+			),
+		},
+		"invalid i-json - integer beyond safe range": {
+			ijson: jsontypes.NewIJSONValue(`{"id": 9007199254740993}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid I-JSON String Value: "+
+					"A string value was provided that is not valid I-JSON string format (RFC 7493).\n\n"+
+					"Error: at \"/id\": integer 9007199254740993 is outside the range -(2^53)+1 to (2^53)-1\n"+
+					"Given Value: {\"id\": 9007199254740993}\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.ijson.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIJSONUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.IJSON
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"ijson value is null ": {
+			json: jsontypes.NewIJSONNull(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"I-JSON Unmarshal Error",
+					"json string value is null",
+				),
+			},
+		},
+		"ijson value is unknown ": {
+			json: jsontypes.NewIJSONUnknown(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"I-JSON Unmarshal Error",
+					"json string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewIJSONValue(`{"hello": "world"}`),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"I-JSON Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Hello string \"json:\\\"hello\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewIJSONValue(`{"hello": "world", "nums": [1, 2, 3], "test-bool": true}`),
+			target: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{},
+			output: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{
+				Hello:   "world",
+				Numbers: []int{1, 2, 3},
+				Test:    true,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
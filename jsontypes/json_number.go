@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// numbersWithinTolerance returns true if the difference between both JSON numbers is within the absolute tolerance, or
+// within the relative tolerance of the larger magnitude. Integers are never compared approximately, so false is returned
+// if neither number has a fraction or exponent, or if either number cannot be represented as a float64.
+func numbersWithinTolerance(a, b string, absolute, relative float64) bool {
+	if isInteger(a) && isInteger(b) {
+		return false
+	}
+
+	aFloat, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return false
+	}
+
+	bFloat, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return false
+	}
+
+	difference := math.Abs(aFloat - bFloat)
+
+	return difference <= absolute || difference <= relative*math.Max(math.Abs(aFloat), math.Abs(bFloat))
+}
+
+// isInteger returns true if the JSON number has neither a fraction nor an exponent.
+func isInteger(number string) bool {
+	return !strings.ContainsAny(number, ".eE")
+}
@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPointerWildcard is a reference token that matches any array index or object member name.
+const jsonPointerWildcard = "*"
+
+// jsonPointer is a parsed JSON Pointer (RFC 6901). Reference tokens are stored unescaped, with the exception
+// of jsonPointerWildcard, which matches any single array index or object member name.
+type jsonPointer []string
+
+// parseJSONPointer parses a JSON Pointer string (RFC 6901), such as "/metadata/createdAt" or "/items/*/etag".
+func parseJSONPointer(s string) (jsonPointer, error) {
+	if s == "" {
+		return jsonPointer{}, nil
+	}
+
+	if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf("invalid JSON Pointer %q: must be empty or begin with \"/\"", s)
+	}
+
+	tokens := strings.Split(s[1:], "/")
+
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] != '~' {
+				continue
+			}
+
+			if j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1') {
+				return nil, fmt.Errorf("invalid JSON Pointer %q: '~' must be followed by '0' or '1'", s)
+			}
+		}
+
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// parseJSONPointers parses each of the given JSON Pointer strings.
+func parseJSONPointers(ss []string) ([]jsonPointer, error) {
+	pointers := make([]jsonPointer, 0, len(ss))
+
+	for _, s := range ss {
+		pointer, err := parseJSONPointer(s)
+		if err != nil {
+			return nil, err
+		}
+
+		pointers = append(pointers, pointer)
+	}
+
+	return pointers, nil
+}
+
+// matches returns true if the pointer refers to the given location, where each wildcard reference token
+// matches any single path step.
+func (p jsonPointer) matches(location []string) bool {
+	if len(p) != len(location) {
+		return false
+	}
+
+	for i, token := range p {
+		if token != jsonPointerWildcard && token != location[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesAny returns true if any of the given pointers refers to the given location.
+func matchesAny(pointers []jsonPointer, location []string) bool {
+	for _, pointer := range pointers {
+		if pointer.matches(location) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// childLocation returns a copy of location with the given reference token appended, so sibling
+// locations never share a backing array.
+func childLocation(location []string, token string) []string {
+	child := make([]string, len(location), len(location)+1)
+	copy(child, location)
+
+	return append(child, token)
+}
+
+// indexLocation returns a copy of location with the given array index appended.
+func indexLocation(location []string, index int) []string {
+	return childLocation(location, strconv.Itoa(index))
+}
+
+// formatJSONPointer returns the JSON Pointer (RFC 6901) string for the given location.
+func formatJSONPointer(location []string) string {
+	var b strings.Builder
+
+	for _, token := range location {
+		b.WriteByte('/')
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+
+	return b.String()
+}
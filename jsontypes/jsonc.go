@@ -0,0 +1,188 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// jsoncToJSON converts a JSONC string (JSON with "//" and "/* */" comments and trailing commas) into a JSON string by
+// replacing each comment and trailing comma with whitespace. A comma is only trailing if it follows a value, so "[,]" is
+// left unchanged. Byte offsets are preserved, so that the location of a syntax error in the result is also its location
+// in the JSONC string. The result is not validated.
+func jsoncToJSON(s string) (string, error) {
+	result := []byte(s)
+
+	// previous is the last byte which is not whitespace or part of a comment.
+	var previous byte
+
+	for i := 0; i < len(result); i++ {
+		switch result[i] {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '"':
+			i = skipJSONString(result, i)
+			previous = '"'
+
+			continue
+		case '/':
+			end, err := commentEnd(result, i)
+			if err != nil {
+				return "", err
+			}
+
+			if end != i {
+				blankOut(result[i:end])
+
+				i = end - 1
+
+				continue
+			}
+		case ',':
+			next, err := nextSignificantByte(result, i+1)
+			if err != nil {
+				return "", err
+			}
+
+			if next < len(result) && (result[next] == '}' || result[next] == ']') && endsJSONValue(previous) {
+				result[i] = ' '
+			}
+		}
+
+		previous = result[i]
+	}
+
+	return string(result), nil
+}
+
+// endsJSONValue returns true if the byte can be the last byte of a JSON value, which is the closing quote of a string,
+// the last digit of a number, the last letter of true, false or null, or a closing bracket.
+func endsJSONValue(b byte) bool {
+	switch b {
+	case '"', '}', ']', 'e', 'l':
+		return true
+	default:
+		return b >= '0' && b <= '9'
+	}
+}
+
+// skipJSONString returns the index of the closing quote of the JSON string starting at the given index, or the last index
+// if the string is unterminated.
+func skipJSONString(b []byte, start int) int {
+	for i := start + 1; i < len(b); i++ {
+		switch b[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+
+	return len(b) - 1
+}
+
+// commentEnd returns the index after the comment starting at the given index, or the given index if there is no comment.
+func commentEnd(b []byte, start int) (int, error) {
+	if start+1 >= len(b) {
+		return start, nil
+	}
+
+	switch b[start+1] {
+	case '/':
+		if end := bytes.IndexByte(b[start:], '\n'); end != -1 {
+			return start + end, nil
+		}
+
+		return len(b), nil
+	case '*':
+		if end := bytes.Index(b[start+2:], []byte("*/")); end != -1 {
+			return start + 2 + end + 2, nil
+		}
+
+		line, column := textPosition(string(b), start)
+
+		return 0, fmt.Errorf("line %d, column %d: unterminated block comment", line, column)
+	default:
+		return start, nil
+	}
+}
+
+// nextSignificantByte returns the index of the next byte from the given index which is not whitespace or part of a
+// comment, or the length of b if there is none.
+func nextSignificantByte(b []byte, start int) (int, error) {
+	for i := start; i < len(b); i++ {
+		switch b[i] {
+		case ' ', '\t', '\n', '\r':
+			continue
+		case '/':
+			end, err := commentEnd(b, i)
+			if err != nil {
+				return 0, err
+			}
+
+			if end == i {
+				return i, nil
+			}
+
+			i = end - 1
+		default:
+			return i, nil
+		}
+	}
+
+	return len(b), nil
+}
+
+// blankOut replaces each byte with a space, other than line breaks, so line numbers are preserved.
+func blankOut(b []byte) {
+	for i := range b {
+		if b[i] != '\n' && b[i] != '\r' {
+			b[i] = ' '
+		}
+	}
+}
+
+// validateJSONC converts the JSONC string into a compact JSON string (RFC 8259). The returned error describes the
+// line and column of any syntax error.
+func validateJSONC(s string) (string, error) {
+	jsonStr, err := jsoncToJSON(s)
+	if err != nil {
+		return "", err
+	}
+
+	var raw json.RawMessage
+
+	if err := json.Unmarshal([]byte(jsonStr), &raw); err != nil {
+		var syntaxErr *json.SyntaxError
+
+		if errors.As(err, &syntaxErr) {
+			line, column := textPosition(s, max(int(syntaxErr.Offset)-1, 0))
+
+			return "", fmt.Errorf("line %d, column %d: %w", line, column, err)
+		}
+
+		return "", err
+	}
+
+	var compact bytes.Buffer
+
+	if err := json.Compact(&compact, raw); err != nil {
+		return "", err
+	}
+
+	return compact.String(), nil
+}
+
+// textPosition returns the 1-based line and column (in characters) of the byte offset within s.
+func textPosition(s string, offset int) (int, int) {
+	offset = min(offset, len(s))
+	lineStart := strings.LastIndexByte(s[:offset], '\n') + 1
+
+	return strings.Count(s[:offset], "\n") + 1, utf8.RuneCountInString(s[lineStart:offset]) + 1
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*JSONCType)(nil)
+)
+
+// JSONCType is an attribute type that represents a valid JSONC string, which is JSON (RFC 7159) that may also contain "//" line
+// comments, "/* */" block comments and trailing commas in objects and arrays. Semantic equality logic is defined for JSONCType
+// such that comments, trailing commas and other inconsequential differences between the strings (whitespace, property order, etc)
+// are ignored, so a JSONC string is equal to a plain JSON string with the same data.
+type JSONCType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t JSONCType) String() string {
+	return "jsontypes.JSONCType"
+}
+
+// ValueType returns the Value type.
+func (t JSONCType) ValueType(ctx context.Context) attr.Value {
+	return JSONC{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t JSONCType) Equal(o attr.Type) bool {
+	other, ok := o.(JSONCType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t JSONCType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JSONC{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t JSONCType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*JSONC)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONC)(nil)
+	_ xattr.ValidateableAttribute                = (*JSONC)(nil)
+	_ function.ValidateableParameter             = (*JSONC)(nil)
+)
+
+// JSONC represents a valid JSONC string, which is JSON (RFC 7159) that may also contain "//" line comments, "/* */" block
+// comments and trailing commas in objects and arrays. Semantic equality logic is defined for JSONC such that comments,
+// trailing commas and other inconsequential differences between the strings (whitespace, property order, etc) are ignored.
+// Use the StrictJSON method to get the value as JSON for sending to an API.
+type JSONC struct {
+	basetypes.StringValue
+}
+
+// Type returns a JSONCType.
+func (v JSONC) Type(_ context.Context) attr.Type {
+	return JSONCType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v JSONC) Equal(o attr.Value) bool {
+	other, ok := o.(JSONC)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given JSONC string value is semantically equal to the current JSONC string value.
+// When compared, comments and trailing commas are removed and the resulting JSON strings are compared like Normalized. This
+// allows a JSONC value from configuration to equal the plain JSON value returned by an API, as JSON is also valid JSONC.
+func (v JSONC) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONC)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := jsoncEqual(ctx, v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+func jsoncEqual(ctx context.Context, s1, s2 string) (bool, error) {
+	s1, err := validateJSONC(s1)
+	if err != nil {
+		return false, err
+	}
+
+	s2, err = validateJSONC(s2)
+	if err != nil {
+		return false, err
+	}
+
+	return (&equalityRules{}).jsonStringsEqual(ctx, s1, s2)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid JSONC format (JSON with comments and trailing commas).
+func (v JSONC) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := validateJSONC(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSONC String Value",
+			"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid JSONC format (JSON with comments and trailing commas).
+func (v JSONC) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := validateJSONC(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid JSONC String Value: "+
+				"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// StrictJSON returns the JSONC StringValue as a compact JSON string (RFC 8259), with comments and trailing commas removed.
+// A null, unknown or invalid value will produce an error diagnostic.
+func (v JSONC) StrictJSON() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("JSONC Conversion Error", "jsonc string value is null"))
+		return "", diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("JSONC Conversion Error", "jsonc string value is unknown"))
+		return "", diags
+	}
+
+	jsonStr, err := validateJSONC(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("JSONC Conversion Error", err.Error()))
+		return "", diags
+	}
+
+	return jsonStr, diags
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the JSONC StringValue, with comments and trailing commas removed, and `target`
+// input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v JSONC) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("JSONC Unmarshal Error", "jsonc string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("JSONC Unmarshal Error", "jsonc string value is unknown"))
+		return diags
+	}
+
+	jsonStr, err := validateJSONC(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("JSONC Unmarshal Error", err.Error()))
+		return diags
+	}
+
+	err = json.Unmarshal([]byte(jsonStr), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("JSONC Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewJSONCNull creates a JSONC with a null value. Determine whether the value is null via IsNull method.
+func NewJSONCNull() JSONC {
+	return JSONC{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewJSONCUnknown creates a JSONC with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewJSONCUnknown() JSONC {
+	return JSONC{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewJSONCValue creates a JSONC with a known value. Access the value via ValueString method.
+func NewJSONCValue(value string) JSONC {
+	return JSONC{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewJSONCPointerValue creates a JSONC with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewJSONCPointerValue(value *string) JSONC {
+	return JSONC{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type JSONCResourceModel struct {
+	Json jsontypes.JSONC `tfsdk:"json"`
+}
+
+type JSONCJson struct {
+	Hello   string `json:"hello"`
+	Numbers []int  `json:"numbers"`
+}
+
+func ExampleJSONC_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := JSONCResourceModel{
+		Json: jsontypes.NewJSONCValue(`{
+			// The greeting
+			"hello": "world",
+			"numbers": [1, 2, 3,],
+		}`),
+	}
+
+	// Check that the JSON data is known and able to be unmarshalled
+	if !data.Json.IsNull() && !data.Json.IsUnknown() {
+		var jsonStruct JSONCJson
+
+		diags.Append(data.Json.Unmarshal(&jsonStruct)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: {world [1 2 3]}
+		fmt.Printf("%v\n", jsonStruct)
+	}
+}
+
+func ExampleJSONC_StrictJSON() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := JSONCResourceModel{
+		Json: jsontypes.NewJSONCValue(`{
+			/* The greeting */
+			"hello": "world", // trailing comma
+		}`),
+	}
+
+	// Check that the JSON data is known and able to be converted
+	if !data.Json.IsNull() && !data.Json.IsUnknown() {
+		jsonStr, jsonDiags := data.Json.StrictJSON()
+
+		diags.Append(jsonDiags...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: {"hello":"world"}
+		fmt.Println(jsonStr)
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestJSONCStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.JSONC
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not equal - mismatched field values": {
+			currentJson: jsontypes.NewJSONCValue(`{
+				// comment
+				"hello": "dlrow",
+			}`),
+			givenJson:     jsontypes.NewJSONCValue(`{"hello": "world"}`),
+			expectedMatch: false,
+		},
+		"not equal - comment markers inside strings are preserved": {
+			currentJson:   jsontypes.NewJSONCValue(`{"url": "http://example.com/*path*/", "trailing": ",}"}`),
+			givenJson:     jsontypes.NewJSONCValue(`{"url": "http:", "trailing": "}"}`),
+			expectedMatch: false,
+		},
+		"semantically equal - comments and trailing commas": {
+			currentJson: jsontypes.NewJSONCValue(`{
+				// The greeting
+				"hello": "world", /* inline */
+				"nums": [1, 2, 3,],
+				"nested": {"test-bool": true,}, // trailing comma
+			}`),
+			givenJson:     jsontypes.NewJSONCValue(`{"hello": "world", "nums": [1, 2, 3], "nested": {"test-bool": true}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - comment before trailing bracket": {
+			currentJson: jsontypes.NewJSONCValue(`[
+				"a",
+				"b", // last
+				/* end */
+			]`),
+			givenJson:     jsontypes.NewJSONCValue(`["a","b"]`),
+			expectedMatch: true,
+		},
+		"semantically equal - comment markers inside strings": {
+			currentJson:   jsontypes.NewJSONCValue(`{"url": "http://example.com/*path*/", "escaped": "\"//,}"}`),
+			givenJson:     jsontypes.NewJSONCValue(`{"escaped": "\"//,}", "url": "http://example.com/*path*/"}`),
+			expectedMatch: true,
+		},
+		"semantically equal - object field order difference": {
+			currentJson:   jsontypes.NewJSONCValue(`{"hello": "world", "nums": [1, 2, 3]}`),
+			givenJson:     jsontypes.NewJSONCValue(`{"nums": [1, 2, 3], "hello": "world"}`),
+			expectedMatch: true,
+		},
+		"error - invalid jsonc": {
+			currentJson:   jsontypes.NewJSONCValue(`{"hello": "world"}`),
+			givenJson:     jsontypes.NewJSONCValue(`{"hello": "world" /* unterminated`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: line 1, column 19: unterminated block comment",
+				),
+			},
+		},
+		"error - not given jsonc value": {
+			currentJson:   jsontypes.NewJSONCValue(`{"hello": "world"}`),
+			givenJson:     basetypes.NewStringValue(`{"hello": "world"}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.JSONC\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONCValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		jsonc         jsontypes.JSONC
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			jsonc: jsontypes.JSONC{},
+		},
+		"null": {
+			jsonc: jsontypes.NewJSONCNull(),
+		},
+		"unknown": {
+			jsonc: jsontypes.NewJSONCUnknown(),
+		},
+		"valid json object": {
+			jsonc: jsontypes.NewJSONCValue(`{"hello":"world", "array": [1, 2, 3]}`),
+		},
+		"valid jsonc object": {
+			jsonc: jsontypes.NewJSONCValue("{\n  // comment\n  \"hello\": \"world\", /* block */\n  \"array\": [1, 2, 3,],\n}"),
+		},
+		"invalid jsonc - bracket mismatch": {
+			jsonc: jsontypes.NewJSONCValue("{\n  \"hello\": \"world\",\n"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSONC String Value",
+					"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+						"Error: line 2, column 20: unexpected end of JSON input\n"+
+						"Given Value: {\n  \"hello\": \"world\",\n\n",
+				),
+			},
+		},
+		"invalid jsonc - unexpected character": {
+			jsonc: jsontypes.NewJSONCValue("{\n  // comment\n  \"hello\": world\n}"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSONC String Value",
+					"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+						"Error: line 3, column 12: invalid character 'w' looking for beginning of value\n"+
+						"Given Value: {\n  // comment\n  \"hello\": world\n}\n",
+				),
+			},
+		},
+		"invalid jsonc - unterminated block comment": {
+			jsonc: jsontypes.NewJSONCValue("{\"hello\": \"world\"}\n/* comment"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSONC String Value",
+					"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+						"Error: line 2, column 1: unterminated block comment\n"+
+						"Given Value: {\"hello\": \"world\"}\n/* comment\n",
+				),
+			},
+		},
+		"invalid jsonc - multiple trailing commas": {
+			jsonc: jsontypes.NewJSONCValue(`[1,,]`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSONC String Value",
+					"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+						"Error: line 1, column 4: invalid character ',' looking for beginning of value\n"+
+						"Given Value: [1,,]\n",
+				),
+			},
+		},
+		"invalid jsonc - comma without value in array": {
+			jsonc: jsontypes.NewJSONCValue(`[,]`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSONC String Value",
+					"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+						"Error: line 1, column 2: invalid character ',' looking for beginning of value\n"+
+						"Given Value: [,]\n",
+				),
+			},
+		},
+		"invalid jsonc - comma without value in object": {
+			jsonc: jsontypes.NewJSONCValue(`{,}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSONC String Value",
+					"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+						"Error: line 1, column 2: invalid character ',' looking for beginning of object key string\n"+
+						"Given Value: {,}\n",
+				),
+			},
+		},
+		"invalid jsonc - comma without value after comment": {
+			jsonc: jsontypes.NewJSONCValue("[\n  , // comment\n]"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSONC String Value",
+					"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+						"Error: line 2, column 3: invalid character ',' looking for beginning of value\n"+
+						"Given Value: [\n  , // comment\n]\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.jsonc.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONCValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		jsonc           jsontypes.JSONC
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			jsonc: jsontypes.JSONC{},
+		},
+		"null": {
+			jsonc: jsontypes.NewJSONCNull(),
+		},
+		"unknown": {
+			jsonc: jsontypes.NewJSONCUnknown(),
+		},
+		"valid jsonc object": {
+			jsonc: jsontypes.NewJSONCValue(`{"hello":"world", /* comment */ "array": [1, 2, 3,],}`),
+		},
+		"invalid jsonc - normal string": {
+			jsonc: jsontypes.NewJSONCValue("notvalidjson123"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid JSONC String Value: "+
+					"A string value was provided that is not valid JSONC string format (JSON with comments and trailing commas).\n\n"+
+					"Error: line 1, column 2: invalid character 'o' in literal null (expecting 'u')\n"+
+					"Given Value: notvalidjson123\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.jsonc.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONCStrictJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		jsonc         jsontypes.JSONC
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"jsonc value is null": {
+			jsonc: jsontypes.NewJSONCNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("JSONC Conversion Error", "jsonc string value is null"),
+			},
+		},
+		"jsonc value is unknown": {
+			jsonc: jsontypes.NewJSONCUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("JSONC Conversion Error", "jsonc string value is unknown"),
+			},
+		},
+		"invalid jsonc": {
+			jsonc: jsontypes.NewJSONCValue(`{"hello": }`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("JSONC Conversion Error", "line 1, column 11: invalid character '}' looking for beginning of value"),
+			},
+		},
+		"valid jsonc": {
+			jsonc:    jsontypes.NewJSONCValue("{\n  // comment\n  \"hello\": \"world\", /* block */\n  \"array\": [1, 2, 3,],\n}"),
+			expected: `{"hello":"world","array":[1,2,3]}`,
+		},
+		"invalid jsonc - comma without value": {
+			jsonc: jsontypes.NewJSONCValue(`{,}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("JSONC Conversion Error", "line 1, column 2: invalid character ',' looking for beginning of object key string"),
+			},
+		},
+		"valid jsonc - trailing comma after literal and comment": {
+			jsonc:    jsontypes.NewJSONCValue(`[true, null, /* comment */]`),
+			expected: `[true,null]`,
+		},
+		"valid json": {
+			jsonc:    jsontypes.NewJSONCValue(`{"url": "http://example.com/<path>"}`),
+			expected: `{"url":"http://example.com/<path>"}`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.jsonc.StrictJSON()
+
+			if got != testCase.expected {
+				t.Errorf("Expected StrictJSON to return: %q, but got: %q", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJSONCUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.JSONC
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"jsonc value is null ": {
+			json: jsontypes.NewJSONCNull(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSONC Unmarshal Error",
+					"jsonc string value is null",
+				),
+			},
+		},
+		"jsonc value is unknown ": {
+			json: jsontypes.NewJSONCUnknown(),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSONC Unmarshal Error",
+					"jsonc string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewJSONCValue(`{"hello": "world"}`),
+			target: struct {
+				Hello string `json:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSONC Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Hello string \"json:\\\"hello\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewJSONCValue(`{"hello": "world", /* comment */ "nums": [1, 2, 3,], "test-bool": true,}`),
+			target: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{},
+			output: &struct {
+				Hello   string `json:"hello"`
+				Numbers []int  `json:"nums"`
+				Test    bool   `json:"test-bool"`
+			}{
+				Hello:   "world",
+				Numbers: []int{1, 2, 3},
+				Test:    true,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
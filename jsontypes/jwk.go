@@ -0,0 +1,338 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// jwkKeyType describes the members of a JSON Web Key type (RFC 7518 and RFC 8037).
+type jwkKeyType struct {
+	// required are the public members which, along with "kty", identify the key and are hashed for its thumbprint (RFC 7638).
+	required []string
+
+	// private are the optional private key members.
+	private []string
+
+	// curves are the coordinate sizes in bytes of the curves supported for the "crv" member, if the key type has one.
+	curves map[string]int
+}
+
+// jwkKeyTypes are the supported values of the "kty" member.
+var jwkKeyTypes = map[string]jwkKeyType{
+	"EC": {
+		required: []string{"crv", "x", "y"},
+		private:  []string{"d"},
+		curves:   map[string]int{"P-256": 32, "P-384": 48, "P-521": 66, "secp256k1": 32},
+	},
+	"OKP": {
+		required: []string{"crv", "x"},
+		private:  []string{"d"},
+		curves:   map[string]int{"Ed25519": 32, "Ed448": 57, "X25519": 32, "X448": 56},
+	},
+	"RSA": {
+		required: []string{"e", "n"},
+		private:  []string{"d", "p", "q", "dp", "dq", "qi"},
+	},
+	"oct": {
+		required: []string{"k"},
+	},
+}
+
+// jwk is a validated JSON Web Key.
+type jwk struct {
+	kty string
+
+	// members are the required members of the key type, as given.
+	members map[string]string
+}
+
+// parseJWKs parses the JWK or JWK Set (RFC 7517) JSON string. The boolean result is true if the string is a JWK Set, in
+// which case each of its keys is returned, otherwise the single key is returned. Errors describe the JSON Pointer (RFC
+// 6901) of the offending member.
+func parseJWKs(jsonStr string) ([]jwk, bool, error) {
+	value, err := decodeValidJSON(jsonStr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, false, fmt.Errorf("at \"\": expected a JWK or JWK Set object, got %s", jsonTypeName(value))
+	}
+
+	members, ok := object["keys"]
+	if !ok {
+		key, err := parseJWK(nil, object)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return []jwk{key}, false, nil
+	}
+
+	elements, ok := members.([]any)
+	if !ok {
+		return nil, true, fmt.Errorf("at \"/keys\": expected an array, got %s", jsonTypeName(members))
+	}
+
+	keys := make([]jwk, 0, len(elements))
+
+	for i, element := range elements {
+		key, err := parseJWK(indexLocation([]string{"keys"}, i), element)
+		if err != nil {
+			return nil, true, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, true, nil
+}
+
+// parseJWK validates the JWK at the given location. The members required by the key type must be present, and the key
+// material and any private key members must be base64url encoded. Curve coordinates must be the size of the curve.
+func parseJWK(location []string, value any) (jwk, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return jwk{}, fmt.Errorf("at %q: expected a JWK object, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	kty, err := jwkStringMember(location, object, "kty")
+	if err != nil {
+		return jwk{}, err
+	}
+
+	keyType, ok := jwkKeyTypes[kty]
+	if !ok {
+		return jwk{}, fmt.Errorf("at %q: unsupported key type %q", formatJSONPointer(childLocation(location, "kty")), kty)
+	}
+
+	for _, name := range []string{"use", "alg", "kid"} {
+		if member, ok := object[name]; ok {
+			if _, ok := member.(string); !ok {
+				return jwk{}, fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(childLocation(location, name)), jsonTypeName(member))
+			}
+		}
+	}
+
+	if keyOps, ok := object["key_ops"]; ok {
+		if err := validateJWKKeyOps(childLocation(location, "key_ops"), keyOps); err != nil {
+			return jwk{}, err
+		}
+	}
+
+	key := jwk{
+		kty:     kty,
+		members: make(map[string]string, len(keyType.required)),
+	}
+
+	for _, name := range keyType.required {
+		member, err := jwkStringMember(location, object, name)
+		if err != nil {
+			return jwk{}, err
+		}
+
+		key.members[name] = member
+	}
+
+	size, ok := keyType.curves[key.members["crv"]]
+	if keyType.curves != nil && !ok {
+		return jwk{}, fmt.Errorf("at %q: unsupported curve %q for key type %q", formatJSONPointer(childLocation(location, "crv")), key.members["crv"], kty)
+	}
+
+	for _, name := range keyType.required {
+		if name == "crv" {
+			continue
+		}
+
+		decoded, err := decodeJWKMember(childLocation(location, name), key.members[name])
+		if err != nil {
+			return jwk{}, err
+		}
+
+		if keyType.curves != nil && len(decoded) != size {
+			return jwk{}, fmt.Errorf("at %q: coordinate must be %d bytes for curve %q, got %d", formatJSONPointer(childLocation(location, name)),
+				size, key.members["crv"], len(decoded))
+		}
+	}
+
+	for _, name := range keyType.private {
+		if _, ok := object[name]; !ok {
+			continue
+		}
+
+		member, err := jwkStringMember(location, object, name)
+		if err != nil {
+			return jwk{}, err
+		}
+
+		if _, err := decodeJWKMember(childLocation(location, name), member); err != nil {
+			return jwk{}, err
+		}
+	}
+
+	return key, nil
+}
+
+// jwkStringMember returns the required string member with the given name of the JWK at the given location.
+func jwkStringMember(location []string, object map[string]any, name string) (string, error) {
+	member, ok := object[name]
+	if !ok {
+		return "", fmt.Errorf("at %q: missing required member %q", formatJSONPointer(location), name)
+	}
+
+	s, ok := member.(string)
+	if !ok {
+		return "", fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(childLocation(location, name)), jsonTypeName(member))
+	}
+
+	return s, nil
+}
+
+// validateJWKKeyOps returns an error if the "key_ops" member at the given location is not an array of unique strings.
+func validateJWKKeyOps(location []string, value any) error {
+	keyOps, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("at %q: expected an array, got %s", formatJSONPointer(location), jsonTypeName(value))
+	}
+
+	seen := make(map[string]bool, len(keyOps))
+
+	for i, keyOp := range keyOps {
+		s, ok := keyOp.(string)
+		if !ok {
+			return fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(indexLocation(location, i)), jsonTypeName(keyOp))
+		}
+
+		if seen[s] {
+			return fmt.Errorf("at %q: duplicate key operation %q", formatJSONPointer(indexLocation(location, i)), s)
+		}
+
+		seen[s] = true
+	}
+
+	return nil
+}
+
+// decodeJWKMember decodes the base64url encoded member at the given location. As required by RFC 7515, the encoding must
+// not have padding.
+func decodeJWKMember(location []string, s string) ([]byte, error) {
+	decoded, err := base64.RawURLEncoding.Strict().DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("at %q: invalid base64url value: %w", formatJSONPointer(location), err)
+	}
+
+	if len(decoded) == 0 {
+		return nil, fmt.Errorf("at %q: value must not be empty", formatJSONPointer(location))
+	}
+
+	return decoded, nil
+}
+
+// thumbprint returns the JWK SHA-256 thumbprint (RFC 7638) of the key, which is the base64url encoded hash of a JSON object
+// containing only "kty" and the required members of the key type, ordered by name and without whitespace.
+func (k jwk) thumbprint() string {
+	members := make(map[string]string, len(k.members)+1)
+
+	for name, member := range k.members {
+		members[name] = member
+	}
+
+	members["kty"] = k.kty
+
+	var b bytes.Buffer
+
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+
+	// Maps are encoded with their keys in sorted order, and the members are strings, so encoding cannot fail.
+	_ = encoder.Encode(members)
+
+	hash := sha256.Sum256(bytes.TrimSuffix(b.Bytes(), []byte{'\n'}))
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// publicKeyMaterial returns a string which is equal for keys with the same key type and public key material. Unlike the
+// thumbprint, leading zero bytes of the RSA modulus and exponent are ignored, as they do not change the integer value.
+func (k jwk) publicKeyMaterial() string {
+	parts := []string{k.kty}
+
+	for _, name := range jwkKeyTypes[k.kty].required {
+		if name == "crv" {
+			parts = append(parts, k.members[name])
+
+			continue
+		}
+
+		// The members were validated when the key was parsed.
+		decoded, _ := base64.RawURLEncoding.DecodeString(k.members[name])
+
+		if name == "e" || name == "n" {
+			decoded = bytes.TrimLeft(decoded, "\x00")
+		}
+
+		parts = append(parts, hex.EncodeToString(decoded))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// jwkEqual returns true if both JWK strings are a single key with the same public key material, or both are JWK Sets
+// whose keys have the same public key material in any order. Metadata such as "kid", "use", "alg" and "key_ops", along with
+// private key members, are not compared.
+func jwkEqual(s1, s2 string) (bool, error) {
+	priorKeys, priorSet, err := parseJWKs(s1)
+	if err != nil {
+		return false, err
+	}
+
+	newKeys, newSet, err := parseJWKs(s2)
+	if err != nil {
+		return false, err
+	}
+
+	if priorSet != newSet {
+		return false, nil
+	}
+
+	return slices.Equal(sortedPublicKeyMaterial(priorKeys), sortedPublicKeyMaterial(newKeys)), nil
+}
+
+// sortedPublicKeyMaterial returns the public key material of each key, sorted so that key order is ignored.
+func sortedPublicKeyMaterial(keys []jwk) []string {
+	materials := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		materials = append(materials, key.publicKeyMaterial())
+	}
+
+	slices.Sort(materials)
+
+	return materials
+}
+
+// jwkThumbprints returns the thumbprint of each key of the JWK or JWK Set string, and whether it is a JWK Set.
+func jwkThumbprints(jsonStr string) ([]string, bool, error) {
+	keys, set, err := parseJWKs(jsonStr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	thumbprints := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		thumbprints = append(thumbprints, key.thumbprint())
+	}
+
+	return thumbprints, set, nil
+}
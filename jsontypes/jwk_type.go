@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*JWKType)(nil)
+)
+
+// JWKType is an attribute type that represents a valid JSON Web Key or JSON Web Key Set string (RFC 7517). The members
+// required for each key type ("EC", "OKP", "RSA" and "oct") are validated. Semantic equality logic is defined for JWKType
+// such that keys with the same public key material are equal, regardless of member order, metadata such as "kid" or
+// private key members.
+type JWKType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t JWKType) String() string {
+	return "jsontypes.JWKType"
+}
+
+// ValueType returns the Value type.
+func (t JWKType) ValueType(ctx context.Context) attr.Value {
+	return JWK{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t JWKType) Equal(o attr.Type) bool {
+	other, ok := o.(JWKType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t JWKType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return JWK{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t JWKType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*JWK)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JWK)(nil)
+	_ xattr.ValidateableAttribute                = (*JWK)(nil)
+	_ function.ValidateableParameter             = (*JWK)(nil)
+)
+
+// JWK represents a valid JSON Web Key or JSON Web Key Set string (RFC 7517), which is an object with a "keys" member
+// containing JSON Web Keys. Semantic equality logic is defined for JWK such that keys are equal if they have the same key
+// type and public key material, so metadata added by an API, such as a "kid" thumbprint, "use" or "alg", does not cause
+// differences. Use the Thumbprint method to get the JWK SHA-256 thumbprint (RFC 7638) of a key.
+//
+// As a JWK can contain private key material, validation diagnostics do not include the given value.
+type JWK struct {
+	basetypes.StringValue
+}
+
+// Type returns a JWKType.
+func (v JWK) Type(_ context.Context) attr.Type {
+	return JWKType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v JWK) Equal(o attr.Value) bool {
+	other, ok := o.(JWK)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given JWK string value is semantically equal to the current JWK string value.
+// Single keys are equal if they have the same key type and public key material, such as the same RSA modulus and exponent
+// or the same curve and coordinates. JWK Sets are equal if their keys are equal in any order. Other members, including
+// metadata and private key members, are not compared, so a private key equals its public key.
+func (v JWK) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JWK)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := jwkEqual(v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid JSON Web Key or JSON Web Key Set (RFC 7517).
+func (v JWK) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, _, err := parseJWKs(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JWK String Value",
+			"A string value was provided that is not valid JSON Web Key or JSON Web Key Set string format (RFC 7517).\n\n"+
+				"Error: "+err.Error()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid JSON Web Key or JSON Web Key Set (RFC 7517).
+func (v JWK) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, _, err := parseJWKs(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid JWK String Value: "+
+				"A string value was provided that is not valid JSON Web Key or JSON Web Key Set string format (RFC 7517).\n\n"+
+				"Error: "+err.Error()+"\n",
+		)
+
+		return
+	}
+}
+
+// Thumbprint returns the JWK SHA-256 thumbprint (RFC 7638) of the JWK StringValue, base64url encoded without padding. A null,
+// unknown or invalid value, or a JWK Set, will produce an error diagnostic.
+func (v JWK) Thumbprint() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	thumbprints, set, thumbprintDiags := v.thumbprints()
+
+	diags.Append(thumbprintDiags...)
+
+	if diags.HasError() {
+		return "", diags
+	}
+
+	if set {
+		diags.Append(diag.NewErrorDiagnostic("JWK Thumbprint Error", "jwk string value is a JWK Set, use the Thumbprints method"))
+		return "", diags
+	}
+
+	return thumbprints[0], diags
+}
+
+// Thumbprints returns the JWK SHA-256 thumbprint (RFC 7638) of each key of the JWK Set StringValue in order, or of the
+// single key if the value is a JWK. A null, unknown or invalid value will produce an error diagnostic.
+func (v JWK) Thumbprints() ([]string, diag.Diagnostics) {
+	thumbprints, _, diags := v.thumbprints()
+
+	return thumbprints, diags
+}
+
+func (v JWK) thumbprints() ([]string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("JWK Thumbprint Error", "jwk string value is null"))
+		return nil, false, diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("JWK Thumbprint Error", "jwk string value is unknown"))
+		return nil, false, diags
+	}
+
+	thumbprints, set, err := jwkThumbprints(v.ValueString())
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("JWK Thumbprint Error", err.Error()))
+		return nil, false, diags
+	}
+
+	return thumbprints, set, diags
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the JWK StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v JWK) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("JWK Unmarshal Error", "jwk string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("JWK Unmarshal Error", "jwk string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("JWK Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewJWKNull creates a JWK with a null value. Determine whether the value is null via IsNull method.
+func NewJWKNull() JWK {
+	return JWK{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewJWKUnknown creates a JWK with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewJWKUnknown() JWK {
+	return JWK{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewJWKValue creates a JWK with a known value. Access the value via ValueString method.
+func NewJWKValue(value string) JWK {
+	return JWK{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewJWKPointerValue creates a JWK with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewJWKPointerValue(value *string) JWK {
+	return JWK{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type JWKResourceModel struct {
+	Key jsontypes.JWK `tfsdk:"key"`
+}
+
+func ExampleJWK_Thumbprint() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := JWKResourceModel{
+		Key: jsontypes.NewJWKValue(`{"kty": "OKP", "crv": "Ed25519", "x": "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`),
+	}
+
+	// Check that the JWK data is known before computing its thumbprint, such as to use as the key ID
+	if !data.Key.IsNull() && !data.Key.IsUnknown() {
+		thumbprint, thumbprintDiags := data.Key.Thumbprint()
+
+		diags.Append(thumbprintDiags...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k
+		fmt.Println(thumbprint)
+	}
+}
@@ -0,0 +1,462 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+const (
+	// testRSAModulus is the modulus of the RSA public key in the example of RFC 7638, section 3.1.
+	testRSAModulus = "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw"
+
+	// testRSAKey is the RSA public key in the example of RFC 7638, section 3.1.
+	testRSAKey = `{"kty":"RSA","n":"` + testRSAModulus + `","e":"AQAB","alg":"RS256","kid":"2011-04-29"}`
+
+	// testECKey is the EC public key in the example of RFC 7517, appendix A.1.
+	testECKey = `{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","use":"enc","kid":"1"}`
+
+	// testECPrivateKey is the EC private key in the example of RFC 7517, appendix A.2.
+	testECPrivateKey = `{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM","d":"870MB6gfuTJ4HtUnUvYMyJpr5eUZNP4Bk43bVdj3eAE","use":"enc","kid":"1"}`
+
+	// testOKPKey is the Ed25519 public key in the example of RFC 8037, appendix A.2.
+	testOKPKey = `{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`
+)
+
+func TestJWKStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.JWK
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentJson:   jsontypes.NewJWKValue(testRSAKey),
+			givenJson:     jsontypes.NewJWKValue(testRSAKey),
+			expectedMatch: true,
+		},
+		"semantically equal - member order and metadata difference": {
+			currentJson:   jsontypes.NewJWKValue(`{"e":"AQAB","n":"` + testRSAModulus + `","kty":"RSA"}`),
+			givenJson:     jsontypes.NewJWKValue(`{"kty":"RSA","kid":"NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs","use":"sig","n":"` + testRSAModulus + `","e":"AQAB"}`),
+			expectedMatch: true,
+		},
+		"semantically equal - rsa modulus leading zero byte": {
+			currentJson:   jsontypes.NewJWKValue(`{"kty":"RSA","n":"AQAB","e":"AQAB"}`),
+			givenJson:     jsontypes.NewJWKValue(`{"kty":"RSA","n":"AAEAAQ","e":"AQAB"}`),
+			expectedMatch: true,
+		},
+		"semantically equal - private key and public key": {
+			currentJson:   jsontypes.NewJWKValue(testECPrivateKey),
+			givenJson:     jsontypes.NewJWKValue(testECKey),
+			expectedMatch: true,
+		},
+		"semantically equal - jwk set key order difference": {
+			currentJson:   jsontypes.NewJWKValue(`{"keys":[` + testECKey + `,` + testRSAKey + `]}`),
+			givenJson:     jsontypes.NewJWKValue(`{"keys":[` + testRSAKey + `,` + testECPrivateKey + `]}`),
+			expectedMatch: true,
+		},
+		"not equal - different public key material": {
+			currentJson:   jsontypes.NewJWKValue(testECKey),
+			givenJson:     jsontypes.NewJWKValue(`{"kty":"EC","crv":"P-256","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyA"}`),
+			expectedMatch: false,
+		},
+		"not equal - different key type": {
+			currentJson:   jsontypes.NewJWKValue(`{"kty":"oct","k":"AQAB"}`),
+			givenJson:     jsontypes.NewJWKValue(`{"kty":"RSA","n":"AQAB","e":"AQAB"}`),
+			expectedMatch: false,
+		},
+		"not equal - jwk and jwk set": {
+			currentJson:   jsontypes.NewJWKValue(testOKPKey),
+			givenJson:     jsontypes.NewJWKValue(`{"keys":[` + testOKPKey + `]}`),
+			expectedMatch: false,
+		},
+		"not equal - jwk set additional key": {
+			currentJson:   jsontypes.NewJWKValue(`{"keys":[` + testOKPKey + `]}`),
+			givenJson:     jsontypes.NewJWKValue(`{"keys":[` + testOKPKey + `,` + testOKPKey + `]}`),
+			expectedMatch: false,
+		},
+		"error - invalid jwk": {
+			currentJson:   jsontypes.NewJWKValue(testOKPKey),
+			givenJson:     jsontypes.NewJWKValue(`{"kty":"OKP","crv":"Ed25519"}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: at \"\": missing required member \"x\"",
+				),
+			},
+		},
+		"error - not given jwk value": {
+			currentJson:   jsontypes.NewJWKValue(testOKPKey),
+			givenJson:     basetypes.NewStringValue(testOKPKey),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.JWK\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJWKValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		jwk           jsontypes.JWK
+		expectedError string
+	}{
+		"empty-struct": {
+			jwk: jsontypes.JWK{},
+		},
+		"null": {
+			jwk: jsontypes.NewJWKNull(),
+		},
+		"unknown": {
+			jwk: jsontypes.NewJWKUnknown(),
+		},
+		"valid rsa key": {
+			jwk: jsontypes.NewJWKValue(testRSAKey),
+		},
+		"valid ec private key": {
+			jwk: jsontypes.NewJWKValue(testECPrivateKey),
+		},
+		"valid okp key": {
+			jwk: jsontypes.NewJWKValue(testOKPKey),
+		},
+		"valid jwk set": {
+			jwk: jsontypes.NewJWKValue(`{"keys":[` + testRSAKey + `,{"kty":"oct","k":"AyM1SysPpbyDfgZld3um","key_ops":["sign","verify"]}]}`),
+		},
+		"valid empty jwk set": {
+			jwk: jsontypes.NewJWKValue(`{"keys":[]}`),
+		},
+		"invalid json": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"oct"`),
+			expectedError: "unexpected end of JSON input",
+		},
+		"invalid jwk - not an object": {
+			jwk:           jsontypes.NewJWKValue(`[]`),
+			expectedError: `at "": expected a JWK or JWK Set object, got array`,
+		},
+		"invalid jwk - missing kty": {
+			jwk:           jsontypes.NewJWKValue(`{"k":"AQAB"}`),
+			expectedError: `at "": missing required member "kty"`,
+		},
+		"invalid jwk - unsupported kty": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"DSA"}`),
+			expectedError: `at "/kty": unsupported key type "DSA"`,
+		},
+		"invalid jwk - rsa missing exponent": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"RSA","n":"` + testRSAModulus + `"}`),
+			expectedError: `at "": missing required member "e"`,
+		},
+		"invalid jwk - ec unsupported curve": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"EC","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","y":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}`),
+			expectedError: `at "/crv": unsupported curve "Ed25519" for key type "EC"`,
+		},
+		"invalid jwk - ec coordinate size": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"EC","crv":"P-384","x":"MKBCTNIcKUSDii11ySs3526iDZ8AiTo7Tu6KPAqv7D4","y":"4Etl6SRW2YiLUrN5vfvVHuhp7x8PxltmWWlbbM4IFyM"}`),
+			expectedError: `at "/x": coordinate must be 48 bytes for curve "P-384", got 32`,
+		},
+		"invalid jwk - base64url padding": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"oct","k":"AQAB=="}`),
+			expectedError: `at "/k": invalid base64url value: illegal base64 data at input byte 4`,
+		},
+		"invalid jwk - private key member not base64url": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"OKP","crv":"Ed25519","x":"11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo","d":"a+b/"}`),
+			expectedError: `at "/d": invalid base64url value: illegal base64 data at input byte 1`,
+		},
+		"invalid jwk - kid not a string": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"oct","k":"AQAB","kid":1}`),
+			expectedError: `at "/kid": expected a string, got number`,
+		},
+		"invalid jwk - duplicate key operation": {
+			jwk:           jsontypes.NewJWKValue(`{"kty":"oct","k":"AQAB","key_ops":["sign","sign"]}`),
+			expectedError: `at "/key_ops/1": duplicate key operation "sign"`,
+		},
+		"invalid jwk set - keys not an array": {
+			jwk:           jsontypes.NewJWKValue(`{"keys":{}}`),
+			expectedError: `at "/keys": expected an array, got object`,
+		},
+		"invalid jwk set - invalid key": {
+			jwk:           jsontypes.NewJWKValue(`{"keys":[` + testOKPKey + `,{"kty":"oct","k":""}]}`),
+			expectedError: `at "/keys/1/k": value must not be empty`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid JWK String Value",
+					"A string value was provided that is not valid JSON Web Key or JSON Web Key Set string format (RFC 7517).\n\n"+
+						"Error: "+testCase.expectedError+"\n",
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.jwk.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJWKValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		jwk             jsontypes.JWK
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			jwk: jsontypes.JWK{},
+		},
+		"null": {
+			jwk: jsontypes.NewJWKNull(),
+		},
+		"unknown": {
+			jwk: jsontypes.NewJWKUnknown(),
+		},
+		"valid jwk": {
+			jwk: jsontypes.NewJWKValue(testECKey),
+		},
+		"invalid jwk - private key is not shown": {
+			jwk: jsontypes.NewJWKValue(`{"kty":"EC","crv":"P-256","d":"870MB6gfuTJ4HtUnUvYMyJpr5eUZNP4Bk43bVdj3eAE"}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid JWK String Value: "+
+					"A string value was provided that is not valid JSON Web Key or JSON Web Key Set string format (RFC 7517).\n\n"+
+					"Error: at \"\": missing required member \"x\"\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.jwk.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJWKThumbprint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		jwk           jsontypes.JWK
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"jwk value is null": {
+			jwk: jsontypes.NewJWKNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("JWK Thumbprint Error", "jwk string value is null"),
+			},
+		},
+		"jwk value is unknown": {
+			jwk: jsontypes.NewJWKUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("JWK Thumbprint Error", "jwk string value is unknown"),
+			},
+		},
+		"invalid jwk": {
+			jwk: jsontypes.NewJWKValue(`{"kty":"oct"}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("JWK Thumbprint Error", `at "": missing required member "k"`),
+			},
+		},
+		"jwk set": {
+			jwk: jsontypes.NewJWKValue(`{"keys":[` + testOKPKey + `]}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("JWK Thumbprint Error", "jwk string value is a JWK Set, use the Thumbprints method"),
+			},
+		},
+		// https://www.rfc-editor.org/rfc/rfc7638#section-3.1
+		"rsa key - RFC 7638": {
+			jwk:      jsontypes.NewJWKValue(testRSAKey),
+			expected: "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs",
+		},
+		// https://www.rfc-editor.org/rfc/rfc8037#appendix-A.3
+		"okp key - RFC 8037": {
+			jwk:      jsontypes.NewJWKValue(testOKPKey),
+			expected: "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k",
+		},
+		"ec private key - same as public key": {
+			jwk:      jsontypes.NewJWKValue(testECPrivateKey),
+			expected: "cn-I_WNMClehiVp51i_0VpOENW1upEerA8sEam5hn-s",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.jwk.Thumbprint()
+
+			if got != testCase.expected {
+				t.Errorf("Expected Thumbprint to return: %q, but got: %q", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestJWKThumbprints(t *testing.T) {
+	t.Parallel()
+
+	got, diags := jsontypes.NewJWKValue(`{"keys":[` + testRSAKey + `,` + testOKPKey + `]}`).Thumbprints()
+
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	expected := []string{"NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs", "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k"}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Unexpected thumbprints (-got, +expected): %s", diff)
+	}
+}
+
+func TestJWKUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.JWK
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"jwk value is null ": {
+			json: jsontypes.NewJWKNull(),
+			target: struct {
+				Kty string `json:"kty"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JWK Unmarshal Error",
+					"jwk string value is null",
+				),
+			},
+		},
+		"jwk value is unknown ": {
+			json: jsontypes.NewJWKUnknown(),
+			target: struct {
+				Kty string `json:"kty"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JWK Unmarshal Error",
+					"jwk string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewJWKValue(testOKPKey),
+			target: struct {
+				Kty string `json:"kty"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JWK Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Kty string \"json:\\\"kty\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			json: jsontypes.NewJWKValue(testOKPKey),
+			target: &struct {
+				Kty string `json:"kty"`
+				Crv string `json:"crv"`
+			}{},
+			output: &struct {
+				Kty string `json:"kty"`
+				Crv string `json:"crv"`
+			}{
+				Kty: "OKP",
+				Crv: "Ed25519",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/internal/yamljson"
+)
+
+// kubernetesServerFields are the fields of a Kubernetes object which are populated by the API server, and so are removed
+// from manifests before they are compared.
+var kubernetesServerFields = []jsonPointer{
+	{"status"},
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "uid"},
+	{"metadata", "creationTimestamp"},
+}
+
+// kubernetesContainerArrays are the member names of the arrays of containers in a pod spec, whose elements are paired by
+// their "name" member.
+var kubernetesContainerArrays = []string{"containers", "initContainers", "ephemeralContainers"}
+
+// parseKubernetesManifest decodes the Kubernetes manifest, which is a JSON or YAML string containing a single document.
+// The manifest must be an object with a non-empty "apiVersion", "kind" and "metadata.name". Errors describe the JSON
+// Pointer (RFC 6901) of the offending member.
+func parseKubernetesManifest(manifest string) (map[string]any, error) {
+	documents, err := yamljson.DecodeDocuments(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(documents) != 1 {
+		return nil, fmt.Errorf("expected a single manifest document, got %d", len(documents))
+	}
+
+	object, ok := documents[0].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("at \"\": expected a manifest object, got %s", jsonTypeName(documents[0]))
+	}
+
+	for _, name := range []string{"apiVersion", "kind"} {
+		if err := kubernetesStringMember(nil, object, name); err != nil {
+			return nil, err
+		}
+	}
+
+	metadata, ok := object["metadata"]
+	if !ok {
+		return nil, fmt.Errorf("at \"\": missing required member \"metadata\"")
+	}
+
+	metadataObject, ok := metadata.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("at \"/metadata\": expected an object, got %s", jsonTypeName(metadata))
+	}
+
+	if err := kubernetesStringMember([]string{"metadata"}, metadataObject, "name"); err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// kubernetesStringMember returns an error if the object at the given location does not have a member with the given name
+// which is a non-empty string.
+func kubernetesStringMember(location []string, object map[string]any, name string) error {
+	member, ok := object[name]
+	if !ok {
+		return fmt.Errorf("at %q: missing required member %q", formatJSONPointer(location), name)
+	}
+
+	s, ok := member.(string)
+	if !ok {
+		return fmt.Errorf("at %q: expected a string, got %s", formatJSONPointer(childLocation(location, name)), jsonTypeName(member))
+	}
+
+	if s == "" {
+		return fmt.Errorf("at %q: must not be empty", formatJSONPointer(childLocation(location, name)))
+	}
+
+	return nil
+}
+
+// kubernetesManifestEqual returns true if the prior Kubernetes manifest is semantically equal to the new manifest. Fields
+// populated by the API server are removed from both manifests, which are then compared like Subset, so the new manifest
+// may contain members defaulted by the API server. Containers are paired by "name" and container ports by
+// "containerPort", rather than by their index.
+func kubernetesManifestEqual(ctx context.Context, s1, s2 string) (bool, error) {
+	priorManifest, err := parseKubernetesManifest(s1)
+	if err != nil {
+		return false, err
+	}
+
+	newManifest, err := parseKubernetesManifest(s2)
+	if err != nil {
+		return false, err
+	}
+
+	// Numbers are compared by value, as the API server may return a number in a different representation than the YAML
+	// manifest, such as "1" for "1.0".
+	r := &equalityRules{
+		ignorePaths:           kubernetesServerFields,
+		subset:                true,
+		compareNumbersByValue: true,
+	}
+
+	priorValue, _ := r.prepare(nil, priorManifest)
+	newValue, _ := r.prepare(nil, newManifest)
+
+	// Merge keys are configured for the location of each array of containers in the prior manifest, as pod specs are
+	// nested at different depths depending on the kind, such as "/spec/template/spec/containers" for a Deployment. Arrays
+	// are compared at the locations of the prior manifest, so those of the new manifest are not needed.
+	r.arrayMergeKeys = kubernetesMergeKeys(nil, priorValue)
+
+	return r.valuesEqual(ctx, priorValue, newValue), nil
+}
+
+// kubernetesMergeKeys returns the merge keys for every array of containers, and the arrays of ports of their elements,
+// within the value at the given location.
+func kubernetesMergeKeys(location []string, value any) []arrayMergeKey {
+	var mergeKeys []arrayMergeKey
+
+	switch value := value.(type) {
+	case map[string]any:
+		for name, member := range value {
+			memberLocation := childLocation(location, name)
+
+			if _, ok := member.([]any); ok && slices.Contains(kubernetesContainerArrays, name) {
+				mergeKeys = append(mergeKeys,
+					arrayMergeKey{path: memberLocation, member: "name"},
+					arrayMergeKey{path: childLocation(childLocation(memberLocation, jsonPointerWildcard), "ports"), member: "containerPort"},
+				)
+			}
+
+			mergeKeys = append(mergeKeys, kubernetesMergeKeys(memberLocation, member)...)
+		}
+	case []any:
+		for i, element := range value {
+			mergeKeys = append(mergeKeys, kubernetesMergeKeys(indexLocation(location, i), element)...)
+		}
+	}
+
+	return mergeKeys
+}
+
+// unmarshalKubernetesManifest calls (encoding/json).Unmarshal with the JSON encoding of the JSON or YAML manifest and the
+// target, so the target is decoded using its JSON struct field tags in either case.
+func unmarshalKubernetesManifest(manifest string, target any) error {
+	documents, err := yamljson.DecodeDocuments(manifest)
+	if err != nil {
+		return err
+	}
+
+	if len(documents) != 1 {
+		return fmt.Errorf("expected a single manifest document, got %d", len(documents))
+	}
+
+	jsonBytes, err := json.Marshal(documents[0])
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(jsonBytes, target)
+}
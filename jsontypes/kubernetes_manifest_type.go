@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*KubernetesManifestType)(nil)
+)
+
+// KubernetesManifestType is an attribute type that represents a valid Kubernetes manifest, which is a JSON (RFC 7159) or
+// YAML (YAML 1.2) string containing a single Kubernetes object. Semantic equality logic is defined for KubernetesManifestType
+// such that fields populated by the API server, such as "status" and "metadata.managedFields", are ignored along with other
+// inconsequential differences between the strings (whitespace, property order, JSON or YAML format, etc).
+type KubernetesManifestType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t KubernetesManifestType) String() string {
+	return "jsontypes.KubernetesManifestType"
+}
+
+// ValueType returns the Value type.
+func (t KubernetesManifestType) ValueType(ctx context.Context) attr.Value {
+	return KubernetesManifest{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t KubernetesManifestType) Equal(o attr.Type) bool {
+	other, ok := o.(KubernetesManifestType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t KubernetesManifestType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return KubernetesManifest{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t KubernetesManifestType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*KubernetesManifest)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*KubernetesManifest)(nil)
+	_ xattr.ValidateableAttribute                = (*KubernetesManifest)(nil)
+	_ function.ValidateableParameter             = (*KubernetesManifest)(nil)
+)
+
+// KubernetesManifest represents a valid Kubernetes manifest, which is a JSON (RFC 7159) or YAML (YAML 1.2) string
+// containing a single Kubernetes object with an "apiVersion", "kind" and "metadata.name". Semantic equality logic is
+// defined for KubernetesManifest such that the fields which the API server populates when the object is created or read
+// back do not cause differences, along with other inconsequential differences between the strings (whitespace, property
+// order, JSON or YAML format, etc).
+type KubernetesManifest struct {
+	basetypes.StringValue
+}
+
+// Type returns a KubernetesManifestType.
+func (v KubernetesManifest) Type(_ context.Context) attr.Type {
+	return KubernetesManifestType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v KubernetesManifest) Equal(o attr.Value) bool {
+	other, ok := o.(KubernetesManifest)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the current Kubernetes manifest string value is semantically equal to the given
+// Kubernetes manifest string value. When compared, the "status", "metadata.managedFields", "metadata.resourceVersion",
+// "metadata.uid" and "metadata.creationTimestamp" fields are removed from both manifests, which are then compared like
+// Subset, so the given value may contain fields defaulted by the API server. Containers, init containers and ephemeral
+// containers are paired by "name", and their ports by "containerPort", so they may be in any order.
+//
+// The current value is expected to be the prior value, such as one derived from configuration, while the given value is
+// expected to be the new value, such as one read back from the API server.
+func (v KubernetesManifest) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(KubernetesManifest)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := kubernetesManifestEqual(ctx, v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is a valid Kubernetes manifest in JSON or YAML format.
+func (v KubernetesManifest) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseKubernetesManifest(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Kubernetes Manifest String Value",
+			"A string value was provided that is not a valid Kubernetes manifest in JSON or YAML string format.\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is a valid Kubernetes manifest in JSON or YAML format.
+func (v KubernetesManifest) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := parseKubernetesManifest(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid Kubernetes Manifest String Value: "+
+				"A string value was provided that is not a valid Kubernetes manifest in JSON or YAML string format.\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the JSON encoding of the KubernetesManifest StringValue and `target` input, so JSON
+// struct field tags are used for both JSON and YAML manifests. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v KubernetesManifest) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Kubernetes Manifest Unmarshal Error", "kubernetes manifest string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Kubernetes Manifest Unmarshal Error", "kubernetes manifest string value is unknown"))
+		return diags
+	}
+
+	err := unmarshalKubernetesManifest(v.ValueString(), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Kubernetes Manifest Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewKubernetesManifestNull creates a KubernetesManifest with a null value. Determine whether the value is null via IsNull method.
+func NewKubernetesManifestNull() KubernetesManifest {
+	return KubernetesManifest{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewKubernetesManifestUnknown creates a KubernetesManifest with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewKubernetesManifestUnknown() KubernetesManifest {
+	return KubernetesManifest{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewKubernetesManifestValue creates a KubernetesManifest with a known value. Access the value via ValueString method.
+func NewKubernetesManifestValue(value string) KubernetesManifest {
+	return KubernetesManifest{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewKubernetesManifestPointerValue creates a KubernetesManifest with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewKubernetesManifestPointerValue(value *string) KubernetesManifest {
+	return KubernetesManifest{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type KubernetesManifestResourceModel struct {
+	Manifest jsontypes.KubernetesManifest `tfsdk:"manifest"`
+}
+
+type KubernetesObject struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+}
+
+func ExampleKubernetesManifest_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := KubernetesManifestResourceModel{
+		Manifest: jsontypes.NewKubernetesManifestValue("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: example\n"),
+	}
+
+	// Check that the manifest data is known and able to be unmarshalled
+	if !data.Manifest.IsNull() && !data.Manifest.IsUnknown() {
+		var object KubernetesObject
+
+		diags.Append(data.Manifest.Unmarshal(&object)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: ConfigMap example
+		fmt.Println(object.Kind, object.Metadata.Name)
+	}
+}
@@ -0,0 +1,371 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestKubernetesManifestStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentJson   jsontypes.KubernetesManifest
+		givenJson     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"semantically equal - byte-for-byte match": {
+			currentJson:   jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example"},"data":{"key":"value"}}`),
+			givenJson:     jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example"},"data":{"key":"value"}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - yaml and json": {
+			currentJson: jsontypes.NewKubernetesManifestValue("apiVersion: v1\n" +
+				"kind: ConfigMap\n" +
+				"metadata:\n" +
+				"  name: example\n" +
+				"data:\n" +
+				"  key: value\n"),
+			givenJson:     jsontypes.NewKubernetesManifestValue(`{"kind":"ConfigMap","apiVersion":"v1","data":{"key":"value"},"metadata":{"name":"example"}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - server populated fields": {
+			currentJson: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example","uid":"b5c5a0f0"},"data":{"key":"value"}}`),
+			givenJson: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example","namespace":"default",` +
+				`"uid":"0c4f7a3e","resourceVersion":"12345","creationTimestamp":"2024-01-01T00:00:00Z",` +
+				`"managedFields":[{"manager":"terraform","operation":"Apply"}]},"data":{"key":"value"},"status":{}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - defaulted fields and container order": {
+			currentJson: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web"},"spec":{"template":{"spec":{"containers":[` +
+				`{"name":"app","image":"nginx","ports":[{"containerPort":80},{"containerPort":443}]},` +
+				`{"name":"sidecar","image":"envoy"}]}}}}`),
+			givenJson: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","generation":1},` +
+				`"spec":{"replicas":1,"template":{"spec":{"restartPolicy":"Always","containers":[` +
+				`{"name":"sidecar","image":"envoy","imagePullPolicy":"Always"},` +
+				`{"name":"app","image":"nginx","imagePullPolicy":"Always","ports":[{"containerPort":443,"protocol":"TCP"},{"containerPort":80,"protocol":"TCP"}]}]}}},` +
+				`"status":{"replicas":1}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - number representations": {
+			currentJson: jsontypes.NewKubernetesManifestValue("apiVersion: apps/v1\n" +
+				"kind: Deployment\n" +
+				"metadata:\n" +
+				"  name: web\n" +
+				"spec:\n" +
+				"  replicas: 3.0\n"),
+			givenJson:     jsontypes.NewKubernetesManifestValue(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web"},"spec":{"replicas":3}}`),
+			expectedMatch: true,
+		},
+		"not equal - different data": {
+			currentJson:   jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example"},"data":{"key":"value"}}`),
+			givenJson:     jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example"},"data":{"key":"other"}}`),
+			expectedMatch: false,
+		},
+		"not equal - configured field removed": {
+			currentJson:   jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example","labels":{"app":"web"}}}`),
+			givenJson:     jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example"}}`),
+			expectedMatch: false,
+		},
+		"not equal - different container image": {
+			currentJson: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"web"},"spec":{"containers":[` +
+				`{"name":"app","image":"nginx:1.25"},{"name":"sidecar","image":"envoy"}]}}`),
+			givenJson: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"web"},"spec":{"containers":[` +
+				`{"name":"sidecar","image":"envoy"},{"name":"app","image":"nginx:1.26"}]}}`),
+			expectedMatch: false,
+		},
+		"not equal - unordered array without merge key": {
+			currentJson:   jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"web"},"spec":{"containers":[{"name":"app","args":["-a","-b"]}]}}`),
+			givenJson:     jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"web"},"spec":{"containers":[{"name":"app","args":["-b","-a"]}]}}`),
+			expectedMatch: false,
+		},
+		"error - invalid manifest": {
+			currentJson:   jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example"}}`),
+			givenJson:     jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{}}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: at \"/metadata\": missing required member \"name\"",
+				),
+			},
+		},
+		"error - not given kubernetes manifest value": {
+			currentJson:   jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example"}}`),
+			givenJson:     basetypes.NewStringValue(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"example"}}`),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.KubernetesManifest\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKubernetesManifestValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		manifest      jsontypes.KubernetesManifest
+		expectedError string
+	}{
+		"empty-struct": {
+			manifest: jsontypes.KubernetesManifest{},
+		},
+		"null": {
+			manifest: jsontypes.NewKubernetesManifestNull(),
+		},
+		"unknown": {
+			manifest: jsontypes.NewKubernetesManifestUnknown(),
+		},
+		"valid json manifest": {
+			manifest: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"example"}}`),
+		},
+		"valid yaml manifest": {
+			manifest: jsontypes.NewKubernetesManifestValue("---\napiVersion: v1\nkind: Namespace\nmetadata:\n  name: example\n"),
+		},
+		"invalid yaml": {
+			manifest:      jsontypes.NewKubernetesManifestValue("apiVersion: v1\n kind: Namespace\n"),
+			expectedError: "yaml: line 2: mapping values are not allowed in this context",
+		},
+		"invalid manifest - multiple documents": {
+			manifest:      jsontypes.NewKubernetesManifestValue("apiVersion: v1\n---\napiVersion: v1\n"),
+			expectedError: "expected a single manifest document, got 2",
+		},
+		"invalid manifest - not an object": {
+			manifest:      jsontypes.NewKubernetesManifestValue(`["apiVersion"]`),
+			expectedError: `at "": expected a manifest object, got array`,
+		},
+		"invalid manifest - missing api version": {
+			manifest:      jsontypes.NewKubernetesManifestValue(`{"kind":"Namespace","metadata":{"name":"example"}}`),
+			expectedError: `at "": missing required member "apiVersion"`,
+		},
+		"invalid manifest - empty kind": {
+			manifest:      jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"","metadata":{"name":"example"}}`),
+			expectedError: `at "/kind": must not be empty`,
+		},
+		"invalid manifest - missing metadata": {
+			manifest:      jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Namespace"}`),
+			expectedError: `at "": missing required member "metadata"`,
+		},
+		"invalid manifest - metadata not an object": {
+			manifest:      jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Namespace","metadata":"example"}`),
+			expectedError: `at "/metadata": expected an object, got string`,
+		},
+		"invalid manifest - name not a string": {
+			manifest:      jsontypes.NewKubernetesManifestValue("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: 1\n"),
+			expectedError: `at "/metadata/name": expected a string, got number`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedDiags diag.Diagnostics
+
+			if testCase.expectedError != "" {
+				expectedDiags.AddAttributeError(
+					path.Root("test"),
+					"Invalid Kubernetes Manifest String Value",
+					"A string value was provided that is not a valid Kubernetes manifest in JSON or YAML string format.\n\n"+
+						"Error: "+testCase.expectedError+"\n"+
+						"Given Value: "+testCase.manifest.ValueString()+"\n",
+				)
+			}
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.manifest.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKubernetesManifestValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		manifest        jsontypes.KubernetesManifest
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			manifest: jsontypes.KubernetesManifest{},
+		},
+		"null": {
+			manifest: jsontypes.NewKubernetesManifestNull(),
+		},
+		"unknown": {
+			manifest: jsontypes.NewKubernetesManifestUnknown(),
+		},
+		"valid manifest": {
+			manifest: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"example"}}`),
+		},
+		"invalid manifest - missing name": {
+			manifest: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Namespace","metadata":{"generateName":"example-"}}`),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid Kubernetes Manifest String Value: "+
+					"A string value was provided that is not a valid Kubernetes manifest in JSON or YAML string format.\n\n"+
+					"Error: at \"/metadata\": missing required member \"name\"\n"+
+					"Given Value: {\"apiVersion\":\"v1\",\"kind\":\"Namespace\",\"metadata\":{\"generateName\":\"example-\"}}\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.manifest.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKubernetesManifestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          jsontypes.KubernetesManifest
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"kubernetes manifest value is null ": {
+			json: jsontypes.NewKubernetesManifestNull(),
+			target: struct {
+				Kind string `json:"kind"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Kubernetes Manifest Unmarshal Error",
+					"kubernetes manifest string value is null",
+				),
+			},
+		},
+		"kubernetes manifest value is unknown ": {
+			json: jsontypes.NewKubernetesManifestUnknown(),
+			target: struct {
+				Kind string `json:"kind"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Kubernetes Manifest Unmarshal Error",
+					"kubernetes manifest string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			json: jsontypes.NewKubernetesManifestValue(`{"kind":"Namespace"}`),
+			target: struct {
+				Kind string `json:"kind"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Kubernetes Manifest Unmarshal Error",
+					"json: Unmarshal(non-pointer struct { Kind string \"json:\\\"kind\\\"\" })",
+				),
+			},
+		},
+		"valid target - json": {
+			json: jsontypes.NewKubernetesManifestValue(`{"apiVersion":"v1","kind":"Namespace"}`),
+			target: &struct {
+				APIVersion string `json:"apiVersion"`
+				Kind       string `json:"kind"`
+			}{},
+			output: &struct {
+				APIVersion string `json:"apiVersion"`
+				Kind       string `json:"kind"`
+			}{
+				APIVersion: "v1",
+				Kind:       "Namespace",
+			},
+		},
+		"valid target - yaml": {
+			json: jsontypes.NewKubernetesManifestValue("apiVersion: v1\nkind: Namespace\n"),
+			target: &struct {
+				APIVersion string `json:"apiVersion"`
+				Kind       string `json:"kind"`
+			}{},
+			output: &struct {
+				APIVersion string `json:"apiVersion"`
+				Kind       string `json:"kind"`
+			}{
+				APIVersion: "v1",
+				Kind:       "Namespace",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.json.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"unicode/utf8"
+)
+
+// recordSeparator is the ASCII Record Separator (RS) character which begins each record of a JSON text sequence (RFC 7464).
+const recordSeparator = 0x1E
+
+// ndjsonRecord is a single record read from newline-delimited JSON or a JSON text sequence.
+type ndjsonRecord struct {
+	// line is the 1-based line number on which the record begins.
+	line int
+
+	// text is the record as written, without its delimiters or surrounding whitespace.
+	text []byte
+
+	// err describes the line and column of a syntax error in the record, if any.
+	err error
+}
+
+// ndjsonRecords returns an iterator over the records read from r. Records are either newline-delimited JSON (NDJSON), with
+// one JSON text per line, or a JSON text sequence (RFC 7464) if the first significant character is RS, where each record
+// begins with RS and may span multiple lines. Blank lines and empty sequence elements are skipped. Records are read one at a
+// time, so the whole input is never buffered, and a syntax error in one record does not stop iteration.
+func ndjsonRecords(r io.Reader) iter.Seq[ndjsonRecord] {
+	return func(yield func(ndjsonRecord) bool) {
+		reader := bufio.NewReader(r)
+
+		// line and column are the 1-based position of the next byte read.
+		line, column := 1, 1
+
+		advance := func(chunk []byte) {
+			if i := bytes.LastIndexByte(chunk, '\n'); i != -1 {
+				line += bytes.Count(chunk, []byte{'\n'})
+				column = utf8.RuneCount(chunk[i+1:]) + 1
+
+				return
+			}
+
+			column += utf8.RuneCount(chunk)
+		}
+
+		delimiter := byte('\n')
+
+		if first, err := peekSignificantByte(reader); err == nil && first == recordSeparator {
+			delimiter = recordSeparator
+		}
+
+		for {
+			chunkLine, chunkColumn := line, column
+
+			chunk, err := reader.ReadBytes(delimiter)
+
+			advance(chunk)
+
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(ndjsonRecord{line: chunkLine, err: err})
+
+				return
+			}
+
+			if delimiter == recordSeparator {
+				chunk = bytes.TrimSuffix(chunk, []byte{recordSeparator})
+			}
+
+			if record, ok := parseNDJSONRecord(chunk, chunkLine, chunkColumn); ok && !yield(record) {
+				return
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// peekSignificantByte returns the first byte from the reader which is not whitespace, without consuming it.
+func peekSignificantByte(reader *bufio.Reader) (byte, error) {
+	for size := 1; ; size++ {
+		peeked, err := reader.Peek(size)
+		if len(peeked) < size {
+			return 0, err
+		}
+
+		switch b := peeked[size-1]; b {
+		case ' ', '\t', '\n', '\r':
+			continue
+		default:
+			return b, nil
+		}
+	}
+}
+
+// parseNDJSONRecord validates the chunk of text between two delimiters, which begins at the given line and column. The
+// boolean result is false if the chunk only contains whitespace.
+func parseNDJSONRecord(chunk []byte, line, column int) (ndjsonRecord, bool) {
+	text := bytes.TrimSpace(chunk)
+	if len(text) == 0 {
+		return ndjsonRecord{}, false
+	}
+
+	leading := bytes.Index(chunk, text)
+	recordLine, recordColumn := line, column
+
+	if i := bytes.LastIndexByte(chunk[:leading], '\n'); i != -1 {
+		recordLine += bytes.Count(chunk[:leading], []byte{'\n'})
+		recordColumn = 1 + leading - (i + 1)
+	} else {
+		recordColumn += leading
+	}
+
+	record := ndjsonRecord{
+		line: recordLine,
+		text: text,
+	}
+
+	var raw json.RawMessage
+
+	if err := json.Unmarshal(text, &raw); err != nil {
+		errLine, errColumn := recordLine, recordColumn
+
+		var syntaxErr *json.SyntaxError
+
+		if errors.As(err, &syntaxErr) {
+			offsetLine, offsetColumn := textPosition(string(text), max(int(syntaxErr.Offset)-1, 0))
+
+			errLine += offsetLine - 1
+			errColumn = offsetColumn
+
+			if offsetLine == 1 {
+				errColumn += recordColumn - 1
+			}
+		}
+
+		record.err = fmt.Errorf("line %d, column %d: %w", errLine, errColumn, err)
+	}
+
+	return record, true
+}
+
+// decodeNDJSON decodes every record of the NDJSON string into Go values, as with decodeJSON. An error is returned for the
+// first invalid record.
+func decodeNDJSON(s string) ([]any, error) {
+	var records []any
+
+	for record := range ndjsonRecords(strings.NewReader(s)) {
+		if record.err != nil {
+			return nil, record.err
+		}
+
+		value, err := decodeJSON(string(record.text))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", record.line, err)
+		}
+
+		records = append(records, value)
+	}
+
+	return records, nil
+}
+
+// ndjsonEqual returns true if both NDJSON strings contain the same number of records and each record is semantically equal
+// to the record in the same position, or to a distinct record in any position if unorderedRecords is true. Records are
+// compared like Normalized.
+func ndjsonEqual(ctx context.Context, s1, s2 string, unorderedRecords bool) (bool, error) {
+	priorRecords, err := decodeNDJSON(s1)
+	if err != nil {
+		return false, err
+	}
+
+	newRecords, err := decodeNDJSON(s2)
+	if err != nil {
+		return false, err
+	}
+
+	c := &comparison{
+		equalityRules: &equalityRules{},
+		ctx:           ctx,
+	}
+
+	if unorderedRecords {
+		return c.elementsMatch(nil, priorRecords, newRecords), nil
+	}
+
+	return c.equal(nil, priorRecords, newRecords), nil
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*NDJSONType)(nil)
+)
+
+// NDJSONType is an attribute type that represents a valid newline-delimited JSON (NDJSON) string, with one JSON text
+// (RFC 7159) per line, or a valid JSON text sequence (RFC 7464), where each JSON text begins with the ASCII Record Separator
+// character. Semantic equality logic is defined for NDJSONType such that records are compared one by one like Normalized,
+// ignoring inconsequential differences between them (whitespace, property order, blank lines, etc).
+//
+// UnorderedRecords can be set if the order of records is not significant. Types with different UnorderedRecords are not equal.
+//
+// Values created by the NewNDJSONValue and other NewNDJSON functions have a NDJSONType without UnorderedRecords. Use
+// the NewValue and other New methods of the type instead for values of a configured type, such as the elements of a
+// collection of that type, as every element must have the element type of the collection.
+type NDJSONType struct {
+	basetypes.StringType
+
+	// UnorderedRecords treats the records as a multiset, so strings with the same records in a different order are considered
+	// equal.
+	UnorderedRecords bool
+}
+
+// String returns a human readable string of the type name.
+func (t NDJSONType) String() string {
+	if t.UnorderedRecords {
+		return "jsontypes.NDJSONType[UnorderedRecords: true]"
+	}
+
+	return "jsontypes.NDJSONType"
+}
+
+// ValueType returns the Value type.
+func (t NDJSONType) ValueType(ctx context.Context) attr.Value {
+	return NDJSON{
+		unorderedRecords: t.UnorderedRecords,
+	}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NDJSONType) Equal(o attr.Type) bool {
+	other, ok := o.(NDJSONType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType) && t.UnorderedRecords == other.UnorderedRecords
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t NDJSONType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return NDJSON{
+		StringValue:      in,
+		unorderedRecords: t.UnorderedRecords,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t NDJSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// NewNull creates a NDJSON with a null value and the UnorderedRecords of the type. Determine whether the value is null
+// via IsNull method.
+func (t NDJSONType) NewNull() NDJSON {
+	return NDJSON{
+		StringValue:      basetypes.NewStringNull(),
+		unorderedRecords: t.UnorderedRecords,
+	}
+}
+
+// NewUnknown creates a NDJSON with an unknown value and the UnorderedRecords of the type. Determine whether the value
+// is unknown via IsUnknown method.
+func (t NDJSONType) NewUnknown() NDJSON {
+	return NDJSON{
+		StringValue:      basetypes.NewStringUnknown(),
+		unorderedRecords: t.UnorderedRecords,
+	}
+}
+
+// NewValue creates a NDJSON with a known value and the UnorderedRecords of the type. Access the value via ValueString
+// method.
+func (t NDJSONType) NewValue(value string) NDJSON {
+	return NDJSON{
+		StringValue:      basetypes.NewStringValue(value),
+		unorderedRecords: t.UnorderedRecords,
+	}
+}
+
+// NewPointerValue creates a NDJSON with a null value if nil or a known value, and the UnorderedRecords of the type.
+// Access the value via ValueStringPointer method.
+func (t NDJSONType) NewPointerValue(value *string) NDJSON {
+	return NDJSON{
+		StringValue:      basetypes.NewStringPointerValue(value),
+		unorderedRecords: t.UnorderedRecords,
+	}
+}
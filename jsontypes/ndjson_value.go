@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*NDJSON)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*NDJSON)(nil)
+	_ xattr.ValidateableAttribute                = (*NDJSON)(nil)
+	_ function.ValidateableParameter             = (*NDJSON)(nil)
+)
+
+// NDJSON represents a valid newline-delimited JSON (NDJSON) string or JSON text sequence (RFC 7464). Semantic equality logic
+// is defined for NDJSON such that records are compared one by one like Normalized, ignoring inconsequential differences
+// between them (whitespace, property order, blank lines, etc). Use the Records method to decode records one at a time.
+type NDJSON struct {
+	basetypes.StringValue
+
+	// unorderedRecords is the UnorderedRecords setting of the NDJSONType that created this value.
+	unorderedRecords bool
+}
+
+// Type returns an NDJSONType.
+func (v NDJSON) Type(_ context.Context) attr.Type {
+	return NDJSONType{
+		UnorderedRecords: v.unorderedRecords,
+	}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v NDJSON) Equal(o attr.Value) bool {
+	other, ok := o.(NDJSON)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given NDJSON string value is semantically equal to the current NDJSON string value.
+// Both values must contain the same number of records, and each record must be semantically equal to the record in the same
+// position, like Normalized. If the NDJSONType that created the current value has UnorderedRecords set, each record must
+// instead be semantically equal to a distinct record in any position. Newline-delimited JSON is equal to a JSON text
+// sequence containing the same records.
+func (v NDJSON) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(NDJSON)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := ndjsonEqual(ctx, v.ValueString(), newValue.ValueString(), v.unorderedRecords)
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid NDJSON format or a JSON text sequence (RFC 7464). An error diagnostic is returned for each invalid record.
+func (v NDJSON) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	for record := range ndjsonRecords(strings.NewReader(v.ValueString())) {
+		if record.err == nil {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid NDJSON String Value",
+			"A string value was provided that is not valid NDJSON string format (newline-delimited JSON or RFC 7464).\n\n"+
+				"Error: "+record.err.Error()+"\n"+
+				"Given Record: "+string(record.text)+"\n",
+		)
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid NDJSON format or a JSON text sequence (RFC 7464).
+func (v NDJSON) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	for record := range ndjsonRecords(strings.NewReader(v.ValueString())) {
+		if record.err == nil {
+			continue
+		}
+
+		resp.Error = function.ConcatFuncErrors(
+			resp.Error,
+			function.NewArgumentFuncError(
+				req.Position,
+				"Invalid NDJSON String Value: "+
+					"A string value was provided that is not valid NDJSON string format (newline-delimited JSON or RFC 7464).\n\n"+
+					"Error: "+record.err.Error()+"\n"+
+					"Given Record: "+string(record.text)+"\n",
+			),
+		)
+	}
+}
+
+// Records returns an iterator over the records of the NDJSON StringValue, which decodes one record at a time rather than
+// the whole value. Each record is yielded as raw JSON, which can be passed to (encoding/json).Unmarshal. An invalid record
+// yields an error describing its line and column, after which iteration continues with the next record. A null or unknown
+// value yields a single error.
+func (v NDJSON) Records() iter.Seq2[json.RawMessage, error] {
+	return func(yield func(json.RawMessage, error) bool) {
+		if v.IsNull() {
+			yield(nil, errors.New("ndjson string value is null"))
+			return
+		}
+
+		if v.IsUnknown() {
+			yield(nil, errors.New("ndjson string value is unknown"))
+			return
+		}
+
+		for record := range ndjsonRecords(strings.NewReader(v.ValueString())) {
+			if record.err != nil {
+				if !yield(nil, record.err) {
+					return
+				}
+
+				continue
+			}
+
+			if !yield(json.RawMessage(record.text), nil) {
+				return
+			}
+		}
+	}
+}
+
+// NewNDJSONNull creates an NDJSON with a null value. Determine whether the value is null via IsNull method.
+func NewNDJSONNull() NDJSON {
+	return NDJSON{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewNDJSONUnknown creates an NDJSON with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewNDJSONUnknown() NDJSON {
+	return NDJSON{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewNDJSONValue creates an NDJSON with a known value. Access the value via ValueString method.
+func NewNDJSONValue(value string) NDJSON {
+	return NDJSON{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewNDJSONPointerValue creates an NDJSON with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewNDJSONPointerValue(value *string) NDJSON {
+	return NDJSON{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
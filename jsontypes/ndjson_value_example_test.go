@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type NDJSONResourceModel struct {
+	Records jsontypes.NDJSON `tfsdk:"records"`
+}
+
+type NDJSONRecord struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+func ExampleNDJSON_Records() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := NDJSONResourceModel{
+		Records: jsontypes.NewNDJSONValue(`{"level": "info", "message": "started"}
+{"level": "warn", "message": "retrying"}
+`),
+	}
+
+	// Check that the NDJSON data is known and able to be decoded
+	if !data.Records.IsNull() && !data.Records.IsUnknown() {
+		for rawRecord, err := range data.Records.Records() {
+			if err != nil {
+				diags.AddError("NDJSON Record Error", err.Error())
+				return
+			}
+
+			var record NDJSONRecord
+
+			if err := json.Unmarshal(rawRecord, &record); err != nil {
+				diags.AddError("NDJSON Record Error", err.Error())
+				return
+			}
+
+			fmt.Printf("%v\n", record)
+		}
+
+		// Output:
+		// {info started}
+		// {warn retrying}
+	}
+}
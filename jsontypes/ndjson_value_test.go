@@ -0,0 +1,350 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestNDJSONStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		unorderedRecords bool
+		currentJson      string
+		givenJson        basetypes.StringValuable
+		expectedMatch    bool
+		expectedDiags    diag.Diagnostics
+	}{
+		"not equal - mismatched record values": {
+			currentJson:   "{\"hello\":\"world\"}\n{\"hello\":\"again\"}\n",
+			givenJson:     jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n{\"hello\":\"there\"}\n"),
+			expectedMatch: false,
+		},
+		"not equal - additional record": {
+			currentJson:   "{\"hello\":\"world\"}\n",
+			givenJson:     jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n{\"hello\":\"world\"}\n"),
+			expectedMatch: false,
+		},
+		"not equal - record order difference": {
+			currentJson:   "{\"id\":1}\n{\"id\":2}\n",
+			givenJson:     jsontypes.NewNDJSONValue("{\"id\":2}\n{\"id\":1}\n"),
+			expectedMatch: false,
+		},
+		"not equal - unordered records with different counts": {
+			unorderedRecords: true,
+			currentJson:      "{\"id\":1}\n{\"id\":1}\n{\"id\":2}\n",
+			givenJson:        jsontypes.NewNDJSONValue("{\"id\":1}\n{\"id\":2}\n{\"id\":2}\n"),
+			expectedMatch:    false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentJson:   "{\"hello\":\"world\"}\n{\"hello\":\"again\"}\n",
+			givenJson:     jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n{\"hello\":\"again\"}\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - whitespace, property order and blank lines": {
+			currentJson:   "{\"hello\": \"world\", \"nums\": [1, 2]}\r\n\r\n  [true, null]  \n\"text\"",
+			givenJson:     jsontypes.NewNDJSONValue("{\"nums\":[1,2],\"hello\":\"world\"}\n[true,null]\n\"text\"\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - json text sequence": {
+			currentJson:   "\x1e{\n  \"hello\": \"world\"\n}\n\x1e\x1e[1, 2]\n",
+			givenJson:     jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n[1,2]\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - empty": {
+			currentJson:   "",
+			givenJson:     jsontypes.NewNDJSONValue("\n\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - unordered records": {
+			unorderedRecords: true,
+			currentJson:      "{\"id\":1}\n{\"id\":2}\n{\"id\":2}\n",
+			givenJson:        jsontypes.NewNDJSONValue("{\"id\":2}\n{\"id\":1}\n{\"id\":2}\n"),
+			expectedMatch:    true,
+		},
+		"error - invalid record": {
+			currentJson:   "{\"hello\":\"world\"}\n",
+			givenJson:     jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n{\"hello\":}\n"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: line 2, column 10: invalid character '}' looking for beginning of value",
+				),
+			},
+		},
+		"error - not given ndjson value": {
+			currentJson:   "{\"hello\":\"world\"}\n",
+			givenJson:     basetypes.NewStringValue("{\"hello\":\"world\"}\n"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.NDJSON\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			typ := jsontypes.NDJSONType{UnorderedRecords: testCase.unorderedRecords}
+
+			valuable, diags := typ.ValueFromString(context.Background(), basetypes.NewStringValue(testCase.currentJson))
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics creating value: %v", diags)
+			}
+
+			currentJson, ok := valuable.(jsontypes.NDJSON)
+			if !ok {
+				t.Fatalf("Expected jsontypes.NDJSON, got %T", valuable)
+			}
+
+			match, diags := currentJson.StringSemanticEquals(context.Background(), testCase.givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNDJSONValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ndjson        jsontypes.NDJSON
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			ndjson: jsontypes.NDJSON{},
+		},
+		"null": {
+			ndjson: jsontypes.NewNDJSONNull(),
+		},
+		"unknown": {
+			ndjson: jsontypes.NewNDJSONUnknown(),
+		},
+		"valid ndjson": {
+			ndjson: jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n{\"nums\":[1,2,3]}\n\n\"text\"\n123"),
+		},
+		"valid json text sequence": {
+			ndjson: jsontypes.NewNDJSONValue("\x1e{\"hello\":\"world\"}\n\x1e{\n  \"nums\": [1, 2, 3]\n}\n"),
+		},
+		"valid empty": {
+			ndjson: jsontypes.NewNDJSONValue(""),
+		},
+		"invalid ndjson - multiple records": {
+			ndjson: jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n{\"hello\":\"world\"\n{\"valid\":true}\n  [1,,2]\n"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NDJSON String Value",
+					"A string value was provided that is not valid NDJSON string format (newline-delimited JSON or RFC 7464).\n\n"+
+						"Error: line 2, column 16: unexpected end of JSON input\n"+
+						"Given Record: {\"hello\":\"world\"\n",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NDJSON String Value",
+					"A string value was provided that is not valid NDJSON string format (newline-delimited JSON or RFC 7464).\n\n"+
+						"Error: line 4, column 6: invalid character ',' looking for beginning of value\n"+
+						"Given Record: [1,,2]\n",
+				),
+			},
+		},
+		"invalid ndjson - multiple values on one line": {
+			ndjson: jsontypes.NewNDJSONValue("{\"hello\":\"world\"} {\"hello\":\"again\"}\n"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NDJSON String Value",
+					"A string value was provided that is not valid NDJSON string format (newline-delimited JSON or RFC 7464).\n\n"+
+						"Error: line 1, column 19: invalid character '{' after top-level value\n"+
+						"Given Record: {\"hello\":\"world\"} {\"hello\":\"again\"}\n",
+				),
+			},
+		},
+		"invalid json text sequence - multi-line record": {
+			ndjson: jsontypes.NewNDJSONValue("\x1e{\"hello\":\"world\"}\n\x1e{\n  \"nums\": [1, 2 3]\n}\n"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid NDJSON String Value",
+					"A string value was provided that is not valid NDJSON string format (newline-delimited JSON or RFC 7464).\n\n"+
+						"Error: line 3, column 17: invalid character '3' after array element\n"+
+						"Given Record: {\n  \"nums\": [1, 2 3]\n}\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.ndjson.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNDJSONValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ndjson          jsontypes.NDJSON
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			ndjson: jsontypes.NDJSON{},
+		},
+		"null": {
+			ndjson: jsontypes.NewNDJSONNull(),
+		},
+		"unknown": {
+			ndjson: jsontypes.NewNDJSONUnknown(),
+		},
+		"valid ndjson": {
+			ndjson: jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n{\"nums\":[1,2,3]}\n"),
+		},
+		"invalid ndjson - multiple records": {
+			ndjson: jsontypes.NewNDJSONValue("notjson\n{\"valid\":true}\n{\"hello\":}\n"),
+			expectedFuncErr: function.ConcatFuncErrors(
+				function.NewArgumentFuncError(
+					0,
+					"Invalid NDJSON String Value: "+
+						"A string value was provided that is not valid NDJSON string format (newline-delimited JSON or RFC 7464).\n\n"+
+						"Error: line 1, column 2: invalid character 'o' in literal null (expecting 'u')\n"+
+						"Given Record: notjson\n",
+				),
+				function.NewArgumentFuncError(
+					0,
+					"Invalid NDJSON String Value: "+
+						"A string value was provided that is not valid NDJSON string format (newline-delimited JSON or RFC 7464).\n\n"+
+						"Error: line 3, column 10: invalid character '}' looking for beginning of value\n"+
+						"Given Record: {\"hello\":}\n",
+				),
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.ndjson.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNDJSONRecords(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ndjson          jsontypes.NDJSON
+		expectedRecords []string
+		expectedErrors  []string
+	}{
+		"null": {
+			ndjson:         jsontypes.NewNDJSONNull(),
+			expectedErrors: []string{"ndjson string value is null"},
+		},
+		"unknown": {
+			ndjson:         jsontypes.NewNDJSONUnknown(),
+			expectedErrors: []string{"ndjson string value is unknown"},
+		},
+		"empty": {
+			ndjson: jsontypes.NewNDJSONValue(""),
+		},
+		"ndjson": {
+			ndjson:          jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\r\n\n  [1, 2, 3]\n\"text\""),
+			expectedRecords: []string{`{"hello":"world"}`, `[1, 2, 3]`, `"text"`},
+		},
+		"json text sequence": {
+			ndjson:          jsontypes.NewNDJSONValue("\x1e{\"hello\":\"world\"}\n\x1e\x1e[\n  1\n]\n"),
+			expectedRecords: []string{`{"hello":"world"}`, "[\n  1\n]"},
+		},
+		"invalid record": {
+			ndjson:          jsontypes.NewNDJSONValue("{\"hello\":\"world\"}\n{\"hello\"}\n[1]\n"),
+			expectedRecords: []string{`{"hello":"world"}`, `[1]`},
+			expectedErrors:  []string{"line 2, column 9: invalid character '}' after object key"},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var records, errors []string
+
+			for record, err := range testCase.ndjson.Records() {
+				if err != nil {
+					errors = append(errors, err.Error())
+					continue
+				}
+
+				if !json.Valid(record) {
+					t.Errorf("Expected valid JSON record, got: %s", record)
+				}
+
+				records = append(records, string(record))
+			}
+
+			if diff := cmp.Diff(records, testCase.expectedRecords); diff != "" {
+				t.Errorf("Unexpected records (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(errors, testCase.expectedErrors); diff != "" {
+				t.Errorf("Unexpected errors (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
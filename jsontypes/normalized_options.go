@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// NormalizedOptions configures the semantic equality logic of NormalizedType and its Normalized values. The zero value
+// compares JSON strings exactly like an unconfigured NormalizedType.
+//
+// Paths are JSON Pointers (RFC 6901), such as "/metadata/createdAt". A "*" reference token matches any single array index
+// or object member name, such as "/items/*/etag".
+type NormalizedOptions struct {
+	// IgnorePaths is a list of JSON Pointers to values which are removed from both JSON strings before they are compared,
+	// such as server-managed fields that are echoed back by an API. The root pointer "" is invalid, as the whole document
+	// cannot be removed.
+	IgnorePaths []string
+
+	// UnorderedArrays treats every JSON array as a multiset, so arrays with the same elements in a different order are
+	// considered equal. The ordering of the prior value is preserved when values are semantically equal.
+	UnorderedArrays bool
+
+	// UnorderedArrayPaths is a list of JSON Pointers to arrays which are treated as multisets, for when only some arrays
+	// in the JSON string are order-insensitive. It has no additional effect if UnorderedArrays is enabled.
+	UnorderedArrayPaths []string
+
+	// IgnoreNullMembers treats object members with a JSON null value as if they were absent, so {"a":1,"b":null} and
+	// {"a":1} are considered equal. Null members are removed recursively; null array elements are kept.
+	IgnoreNullMembers bool
+
+	// CompareNumbersByValue compares JSON numbers by their exact decimal value, rather than their representation, so
+	// 1, 1.0, 1e0 and 10E-1 are considered equal. Numbers are never converted to float64, so no precision is lost.
+	CompareNumbersByValue bool
+
+	// ArrayMergeKeys maps JSON Pointers of arrays of objects to the name of an identity member, such as "name" or "id",
+	// which is used to pair elements instead of their index. Arrays with the same elements in a different order are
+	// considered equal, while an element is only reported as a difference when the element with the same identity differs.
+	// If any element is not an object containing the identity member, or identities are not unique, the array is compared
+	// as if no merge key was configured. Identities are compared exactly, except that scalar identities considered equal
+	// by CompareNumbersByValue or CoerceScalars are paired, such as 1 and "1.0" when both are enabled.
+	ArrayMergeKeys map[string]string
+
+	// NumberTolerance compares JSON numbers approximately, so that floating-point values rounded by an API are considered
+	// equal. The zero value disables approximate comparison.
+	NumberTolerance NumberTolerance
+
+	// Comparators maps JSON Pointers to provider-defined Comparator implementations, which determine whether the values at
+	// matching paths are semantically equal instead of any other options. Values at paths within a matching value are not
+	// compared further, so the Comparator is responsible for the entire value.
+	Comparators map[string]Comparator
+
+	// CoerceScalars considers a JSON string equal to a JSON number or boolean if the string contains the same scalar, for
+	// APIs that return numbers and booleans as strings. For example, "3" is equal to 3 and "true" is equal to true. The
+	// string must be a valid JSON number, which is then compared according to the other number options.
+	CoerceScalars bool
+
+	// EmptyArraysAsNull considers empty JSON arrays, null and absent object members interchangeable, for APIs which
+	// disagree on how an unset collection is represented. Enabling this option also treats object members with a JSON
+	// null value as absent, as with IgnoreNullMembers.
+	EmptyArraysAsNull bool
+
+	// EmptyObjectsAsNull considers empty JSON objects, null and absent object members interchangeable, including objects
+	// which only become empty once any null or absent-equivalent members are removed. Enabling this option also treats
+	// object members with a JSON null value as absent, as with IgnoreNullMembers.
+	EmptyObjectsAsNull bool
+
+	// EmbeddedJSON compares JSON string values which contain a JSON object or array, such as {"input":"{\"a\":1}"}, as
+	// embedded JSON documents instead of strings, so inconsequential differences within them are ignored. The embedded
+	// documents are compared using the same options, where paths continue through the string value, such as "/input/a".
+	// Strings which do not contain a valid JSON object or array are compared as strings.
+	EmbeddedJSON bool
+
+	// EmbeddedJSONPaths is a list of JSON Pointers to string values which are compared as embedded JSON documents, for when
+	// only some strings in the JSON string contain JSON. It has no additional effect if EmbeddedJSON is enabled.
+	EmbeddedJSONPaths []string
+}
+
+// NumberTolerance configures approximate comparison of JSON numbers. Two numbers are considered equal if the absolute
+// difference between them is within Absolute, or within Relative multiplied by the larger magnitude of the two numbers.
+//
+// Numbers are converted to float64 for approximate comparison, which is only performed if either number has a fraction
+// or exponent, so integers such as IDs are always compared exactly.
+type NumberTolerance struct {
+	// Absolute is the maximum absolute difference between two equal numbers, such as 1e-6. It must not be negative.
+	Absolute float64
+
+	// Relative is the maximum difference between two equal numbers relative to the larger magnitude, such as 1e-9.
+	// It must not be negative.
+	Relative float64
+
+	// Paths is a list of JSON Pointers to the numbers which are compared approximately. If empty, all numbers are.
+	Paths []string
+}
+
+// Equal returns true if the given tolerance is equivalent.
+func (t NumberTolerance) Equal(other NumberTolerance) bool {
+	return t.Absolute == other.Absolute && t.Relative == other.Relative && stringSetsEqual(t.Paths, other.Paths)
+}
+
+// String returns a human readable string of the tolerance.
+func (t NumberTolerance) String() string {
+	if len(t.Paths) > 0 {
+		return fmt.Sprintf("{Absolute: %g, Relative: %g, Paths: %q}", t.Absolute, t.Relative, sortedStrings(t.Paths))
+	}
+
+	return fmt.Sprintf("{Absolute: %g, Relative: %g}", t.Absolute, t.Relative)
+}
+
+// enabled returns true if approximate comparison is configured.
+func (t NumberTolerance) enabled() bool {
+	return t.Absolute != 0 || t.Relative != 0
+}
+
+// Equal returns true if the given options are equivalent.
+func (o NormalizedOptions) Equal(other NormalizedOptions) bool {
+	return stringSetsEqual(o.IgnorePaths, other.IgnorePaths) &&
+		o.UnorderedArrays == other.UnorderedArrays &&
+		stringSetsEqual(o.UnorderedArrayPaths, other.UnorderedArrayPaths) &&
+		o.IgnoreNullMembers == other.IgnoreNullMembers &&
+		o.CompareNumbersByValue == other.CompareNumbersByValue &&
+		maps.Equal(o.ArrayMergeKeys, other.ArrayMergeKeys) &&
+		o.NumberTolerance.Equal(other.NumberTolerance) &&
+		maps.EqualFunc(o.Comparators, other.Comparators, comparatorsEqual) &&
+		o.CoerceScalars == other.CoerceScalars &&
+		o.EmptyArraysAsNull == other.EmptyArraysAsNull &&
+		o.EmptyObjectsAsNull == other.EmptyObjectsAsNull &&
+		o.EmbeddedJSON == other.EmbeddedJSON &&
+		stringSetsEqual(o.EmbeddedJSONPaths, other.EmbeddedJSONPaths)
+}
+
+// String returns a human readable string of the configured options, or an empty string if no options are configured.
+func (o NormalizedOptions) String() string {
+	var fields []string
+
+	if len(o.IgnorePaths) > 0 {
+		fields = append(fields, fmt.Sprintf("IgnorePaths: %q", sortedStrings(o.IgnorePaths)))
+	}
+
+	if o.UnorderedArrays {
+		fields = append(fields, "UnorderedArrays: true")
+	}
+
+	if len(o.UnorderedArrayPaths) > 0 {
+		fields = append(fields, fmt.Sprintf("UnorderedArrayPaths: %q", sortedStrings(o.UnorderedArrayPaths)))
+	}
+
+	if o.IgnoreNullMembers {
+		fields = append(fields, "IgnoreNullMembers: true")
+	}
+
+	if o.CompareNumbersByValue {
+		fields = append(fields, "CompareNumbersByValue: true")
+	}
+
+	if len(o.ArrayMergeKeys) > 0 {
+		// Maps are printed with sorted keys, so the result is deterministic.
+		fields = append(fields, fmt.Sprintf("ArrayMergeKeys: %q", o.ArrayMergeKeys))
+	}
+
+	if o.NumberTolerance.enabled() {
+		fields = append(fields, "NumberTolerance: "+o.NumberTolerance.String())
+	}
+
+	if len(o.Comparators) > 0 {
+		comparators := make([]string, 0, len(o.Comparators))
+
+		for _, path := range slices.Sorted(maps.Keys(o.Comparators)) {
+			comparators = append(comparators, fmt.Sprintf("%q:%T", path, o.Comparators[path]))
+		}
+
+		fields = append(fields, "Comparators: map["+strings.Join(comparators, " ")+"]")
+	}
+
+	if o.CoerceScalars {
+		fields = append(fields, "CoerceScalars: true")
+	}
+
+	if o.EmptyArraysAsNull {
+		fields = append(fields, "EmptyArraysAsNull: true")
+	}
+
+	if o.EmptyObjectsAsNull {
+		fields = append(fields, "EmptyObjectsAsNull: true")
+	}
+
+	if o.EmbeddedJSON {
+		fields = append(fields, "EmbeddedJSON: true")
+	}
+
+	if len(o.EmbeddedJSONPaths) > 0 {
+		fields = append(fields, fmt.Sprintf("EmbeddedJSONPaths: %q", sortedStrings(o.EmbeddedJSONPaths)))
+	}
+
+	return strings.Join(fields, ", ")
+}
+
+// value returns the options the pointer refers to, or the zero value if the pointer is nil.
+func (o *NormalizedOptions) value() NormalizedOptions {
+	if o == nil {
+		return NormalizedOptions{}
+	}
+
+	return *o
+}
+
+// rules returns the compiled semantic equality rules for the options, or an error if the options are invalid.
+func (o NormalizedOptions) rules() (*equalityRules, error) {
+	ignorePaths, err := parseJSONPointers(o.IgnorePaths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IgnorePaths: %w", err)
+	}
+
+	if slices.Contains(o.IgnorePaths, "") {
+		return nil, fmt.Errorf("invalid IgnorePaths: the root JSON Pointer \"\" cannot be ignored")
+	}
+
+	unorderedArrayPaths, err := parseJSONPointers(o.UnorderedArrayPaths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid UnorderedArrayPaths: %w", err)
+	}
+
+	arrayMergeKeys := make([]arrayMergeKey, 0, len(o.ArrayMergeKeys))
+
+	// Paths are sorted so the merge key used for a location matched by multiple paths is deterministic.
+	for _, path := range slices.Sorted(maps.Keys(o.ArrayMergeKeys)) {
+		pointer, err := parseJSONPointer(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ArrayMergeKeys: %w", err)
+		}
+
+		arrayMergeKeys = append(arrayMergeKeys, arrayMergeKey{
+			path:   pointer,
+			member: o.ArrayMergeKeys[path],
+		})
+	}
+
+	if o.NumberTolerance.Absolute < 0 || o.NumberTolerance.Relative < 0 {
+		return nil, fmt.Errorf("invalid NumberTolerance: tolerances must not be negative")
+	}
+
+	numberTolerancePaths, err := parseJSONPointers(o.NumberTolerance.Paths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid NumberTolerance: %w", err)
+	}
+
+	comparators := make([]pathComparator, 0, len(o.Comparators))
+
+	// Paths are sorted so the Comparator used for a location matched by multiple paths is deterministic.
+	for _, path := range slices.Sorted(maps.Keys(o.Comparators)) {
+		pointer, err := parseJSONPointer(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Comparators: %w", err)
+		}
+
+		if o.Comparators[path] == nil {
+			return nil, fmt.Errorf("invalid Comparators: nil Comparator for %q", path)
+		}
+
+		comparators = append(comparators, pathComparator{
+			path:       pointer,
+			comparator: o.Comparators[path],
+		})
+	}
+
+	embeddedJSONPaths, err := parseJSONPointers(o.EmbeddedJSONPaths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EmbeddedJSONPaths: %w", err)
+	}
+
+	return &equalityRules{
+		ignorePaths:           ignorePaths,
+		unorderedArrays:       o.UnorderedArrays,
+		unorderedArrayPaths:   unorderedArrayPaths,
+		ignoreNullMembers:     o.IgnoreNullMembers || o.EmptyArraysAsNull || o.EmptyObjectsAsNull,
+		compareNumbersByValue: o.CompareNumbersByValue,
+		arrayMergeKeys:        arrayMergeKeys,
+		absoluteTolerance:     o.NumberTolerance.Absolute,
+		relativeTolerance:     o.NumberTolerance.Relative,
+		numberTolerancePaths:  numberTolerancePaths,
+		comparators:           comparators,
+		coerceScalars:         o.CoerceScalars,
+		emptyArraysAsNull:     o.EmptyArraysAsNull,
+		emptyObjectsAsNull:    o.EmptyObjectsAsNull,
+		embeddedJSON:          o.EmbeddedJSON,
+		embeddedJSONPaths:     embeddedJSONPaths,
+	}, nil
+}
+
+// comparatorsEqual returns true if both Comparators are equal. Values which are comparable, including those of structs
+// with interface fields holding comparable values, are compared with the == operator. Other values are compared with
+// reflect.DeepEqual, as comparing them with == panics, so func values are never equal as they may capture different
+// variables.
+func comparatorsEqual(a, b Comparator) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+
+	if reflect.ValueOf(a).Comparable() {
+		return a == b
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// sortedStrings returns a sorted copy of ss with duplicates removed.
+func sortedStrings(ss []string) []string {
+	sorted := slices.Clone(ss)
+	slices.Sort(sorted)
+
+	return slices.Compact(sorted)
+}
+
+// stringSetsEqual returns true if a and b contain the same strings, ignoring order and duplicates.
+func stringSetsEqual(a, b []string) bool {
+	return slices.Equal(sortedStrings(a), sortedStrings(b))
+}
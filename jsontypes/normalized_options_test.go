@@ -0,0 +1,813 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+func TestNormalizedOptionsStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		options       jsontypes.NormalizedOptions
+		currentJson   string
+		givenJson     string
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"ignore paths - semantically equal - ignored member differs": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"/etag", "/metadata/createdAt"},
+			},
+			currentJson:   `{"name": "example", "etag": "abc", "metadata": {"createdAt": "2024-01-01", "owner": "me"}}`,
+			givenJson:     `{"name": "example", "etag": "def", "metadata": {"createdAt": "2024-02-02", "owner": "me"}}`,
+			expectedMatch: true,
+		},
+		"ignore paths - semantically equal - ignored member only present in one value": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"/etag"},
+			},
+			currentJson:   `{"name": "example"}`,
+			givenJson:     `{"name": "example", "etag": "def"}`,
+			expectedMatch: true,
+		},
+		"ignore paths - semantically equal - wildcard array index": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"/items/*/etag"},
+			},
+			currentJson:   `{"items": [{"name": "a", "etag": "1"}, {"name": "b"}]}`,
+			givenJson:     `{"items": [{"name": "a", "etag": "2"}, {"name": "b", "etag": "3"}]}`,
+			expectedMatch: true,
+		},
+		"ignore paths - semantically equal - escaped reference tokens": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"/annotations/example.com~1updated", "/a~0b"},
+			},
+			currentJson:   `{"annotations": {"example.com/updated": "1"}, "a~b": 1, "name": "example"}`,
+			givenJson:     `{"annotations": {"example.com/updated": "2"}, "a~b": 2, "name": "example"}`,
+			expectedMatch: true,
+		},
+		"ignore paths - not equal - other member differs": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"/etag"},
+			},
+			currentJson:   `{"name": "example", "etag": "abc"}`,
+			givenJson:     `{"name": "changed", "etag": "def"}`,
+			expectedMatch: false,
+		},
+		"ignore paths - not equal - path is not ignored at other depths": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"/etag"},
+			},
+			currentJson:   `{"nested": {"etag": "abc"}}`,
+			givenJson:     `{"nested": {"etag": "def"}}`,
+			expectedMatch: false,
+		},
+		"ignore paths - error - invalid pointer": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"etag"},
+			},
+			currentJson:   `{"etag": "abc"}`,
+			givenJson:     `{"etag": "abc"}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid IgnorePaths: invalid JSON Pointer \"etag\": must be empty or begin with \"/\"",
+				),
+			},
+		},
+		"ignore paths - error - invalid pointer escape": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"/a~2b"},
+			},
+			currentJson:   `{"etag": "abc"}`,
+			givenJson:     `{"etag": "abc"}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid IgnorePaths: invalid JSON Pointer \"/a~2b\": '~' must be followed by '0' or '1'",
+				),
+			},
+		},
+		"ignore paths - error - root pointer": {
+			options: jsontypes.NormalizedOptions{
+				IgnorePaths: []string{"/etag", ""},
+			},
+			currentJson:   `{"etag": "abc"}`,
+			givenJson:     `{"etag": "def"}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid IgnorePaths: the root JSON Pointer \"\" cannot be ignored",
+				),
+			},
+		},
+		"unordered arrays - semantically equal - array item order difference": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrays: true,
+			},
+			currentJson:   `[{"nums":[1, 2, 3]}, {"hello": "world"}, {"nested": {"test-bool": true}}]`,
+			givenJson:     `[{"hello": "world"}, {"nums":[3, 1, 2]}, {"nested": {"test-bool": true}}]`,
+			expectedMatch: true,
+		},
+		"unordered arrays - semantically equal - duplicate elements": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrays: true,
+			},
+			currentJson:   `{"tags": ["a", "b", "a"]}`,
+			givenJson:     `{"tags": ["a", "a", "b"]}`,
+			expectedMatch: true,
+		},
+		"unordered arrays - not equal - duplicate element counts differ": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrays: true,
+			},
+			currentJson:   `{"tags": ["a", "b", "b"]}`,
+			givenJson:     `{"tags": ["a", "a", "b"]}`,
+			expectedMatch: false,
+		},
+		"unordered arrays - not equal - element differs": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrays: true,
+			},
+			currentJson:   `{"cidrs": ["10.0.0.0/16", "10.1.0.0/16"]}`,
+			givenJson:     `{"cidrs": ["10.1.0.0/16", "10.2.0.0/16"]}`,
+			expectedMatch: false,
+		},
+		"unordered arrays - not equal - length differs": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrays: true,
+			},
+			currentJson:   `{"cidrs": ["10.0.0.0/16"]}`,
+			givenJson:     `{"cidrs": ["10.0.0.0/16", "10.0.0.0/16"]}`,
+			expectedMatch: false,
+		},
+		"unordered array paths - semantically equal - configured path order difference": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrayPaths: []string{"/tags", "/rules/*/principals"},
+			},
+			currentJson:   `{"tags": ["a", "b"], "rules": [{"principals": ["x", "y"]}, {"principals": ["z"]}]}`,
+			givenJson:     `{"tags": ["b", "a"], "rules": [{"principals": ["y", "x"]}, {"principals": ["z"]}]}`,
+			expectedMatch: true,
+		},
+		"unordered array paths - not equal - unconfigured path order difference": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrayPaths: []string{"/tags"},
+			},
+			currentJson:   `{"tags": ["a", "b"], "steps": ["first", "second"]}`,
+			givenJson:     `{"tags": ["b", "a"], "steps": ["second", "first"]}`,
+			expectedMatch: false,
+		},
+		"unordered array paths - error - invalid pointer": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrayPaths: []string{"tags"},
+			},
+			currentJson:   `{"tags": ["a", "b"]}`,
+			givenJson:     `{"tags": ["a", "b"]}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid UnorderedArrayPaths: invalid JSON Pointer \"tags\": must be empty or begin with \"/\"",
+				),
+			},
+		},
+		"ignore null members - semantically equal - object additional null field": {
+			options: jsontypes.NormalizedOptions{
+				IgnoreNullMembers: true,
+			},
+			currentJson:   `{"hello": "world", "nums": [1, 2, 3], "nested": {"test-bool": true}, "new-field": null}`,
+			givenJson:     `{"hello": "world", "nums": [1, 2, 3], "nested": {"test-bool": true}}`,
+			expectedMatch: true,
+		},
+		"ignore null members - semantically equal - nested null fields": {
+			options: jsontypes.NormalizedOptions{
+				IgnoreNullMembers: true,
+			},
+			currentJson:   `[{"hello": "world"}, {"nested": {"test-bool": true}}]`,
+			givenJson:     `[{"hello": "world", "unset": null}, {"nested": {"test-bool": true, "new-field": null}}]`,
+			expectedMatch: true,
+		},
+		"ignore null members - not equal - null array elements are kept": {
+			options: jsontypes.NormalizedOptions{
+				IgnoreNullMembers: true,
+			},
+			currentJson:   `{"nums": [1, null]}`,
+			givenJson:     `{"nums": [1]}`,
+			expectedMatch: false,
+		},
+		"ignore null members - not equal - null and empty object": {
+			options: jsontypes.NormalizedOptions{
+				IgnoreNullMembers: true,
+			},
+			currentJson:   `{"nested": {"unset": null}}`,
+			givenJson:     `{}`,
+			expectedMatch: false,
+		},
+		"compare numbers by value - semantically equal - different JSON number representations": {
+			options: jsontypes.NormalizedOptions{
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `{"large": 12423434, "nums": [1, 1.0, 1e0, 10E-1, 0.1e+1]}`,
+			givenJson:     `{"large": 1.2423434e+07, "nums": [1, 1, 1, 1, 1]}`,
+			expectedMatch: true,
+		},
+		"compare numbers by value - semantically equal - zero representations": {
+			options: jsontypes.NormalizedOptions{
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `[0, -0, 0.0, 0e10, -0.000E-5]`,
+			givenJson:     `[0, 0, 0, 0, 0]`,
+			expectedMatch: true,
+		},
+		"compare numbers by value - semantically equal - larger than max float64 values": {
+			options: jsontypes.NormalizedOptions{
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `{"large": 1.79769313486231570814527423731704356798070e+309}`,
+			givenJson:     `{"large": 179769313486231570814527423731704356798070e+268}`,
+			expectedMatch: true,
+		},
+		"compare numbers by value - not equal - beyond float64 precision": {
+			options: jsontypes.NormalizedOptions{
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `{"id": 12345678901234567890}`,
+			givenJson:     `{"id": 12345678901234567891}`,
+			expectedMatch: false,
+		},
+		"compare numbers by value - not equal - different sign": {
+			options: jsontypes.NormalizedOptions{
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `{"offset": -5.0}`,
+			givenJson:     `{"offset": 5}`,
+			expectedMatch: false,
+		},
+		"compare numbers by value - not equal - number and string": {
+			options: jsontypes.NormalizedOptions{
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `{"count": 5}`,
+			givenJson:     `{"count": "5"}`,
+			expectedMatch: false,
+		},
+		"array merge keys - semantically equal - elements in different order": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys: map[string]string{"/containers": "name"},
+			},
+			currentJson:   `{"containers": [{"name": "a", "image": "nginx"}, {"name": "b", "image": "redis"}]}`,
+			givenJson:     `{"containers": [{"image": "redis", "name": "b"}, {"image": "nginx", "name": "a"}]}`,
+			expectedMatch: true,
+		},
+		"array merge keys - semantically equal - wildcard path and numeric identity": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys: map[string]string{"/listeners/*/rules": "id"},
+			},
+			currentJson:   `{"listeners": [{"rules": [{"id": 1, "action": "allow"}, {"id": 2, "action": "deny"}]}]}`,
+			givenJson:     `{"listeners": [{"rules": [{"id": 2, "action": "deny"}, {"id": 1, "action": "allow"}]}]}`,
+			expectedMatch: true,
+		},
+		"array merge keys - not equal - element with same identity differs": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys: map[string]string{"/containers": "name"},
+			},
+			currentJson:   `{"containers": [{"name": "a", "image": "nginx"}, {"name": "b", "image": "redis"}]}`,
+			givenJson:     `{"containers": [{"name": "b", "image": "redis:7"}, {"name": "a", "image": "nginx"}]}`,
+			expectedMatch: false,
+		},
+		"array merge keys - not equal - different identities": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys: map[string]string{"/containers": "name"},
+			},
+			currentJson:   `{"containers": [{"name": "a"}, {"name": "b"}]}`,
+			givenJson:     `{"containers": [{"name": "a"}, {"name": "c"}]}`,
+			expectedMatch: false,
+		},
+		"array merge keys - not equal - different number of elements": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys: map[string]string{"/containers": "name"},
+			},
+			currentJson:   `{"containers": [{"name": "a"}]}`,
+			givenJson:     `{"containers": [{"name": "a"}, {"name": "b"}]}`,
+			expectedMatch: false,
+		},
+		"array merge keys - not equal - missing identity falls back to ordered comparison": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys: map[string]string{"/containers": "name"},
+			},
+			currentJson:   `{"containers": [{"name": "a"}, {"image": "redis"}]}`,
+			givenJson:     `{"containers": [{"image": "redis"}, {"name": "a"}]}`,
+			expectedMatch: false,
+		},
+		"array merge keys - semantically equal - duplicate identities fall back to unordered comparison": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys:  map[string]string{"/containers": "name"},
+				UnorderedArrays: true,
+			},
+			currentJson:   `{"containers": [{"name": "a", "port": 1}, {"name": "a", "port": 2}]}`,
+			givenJson:     `{"containers": [{"name": "a", "port": 2}, {"name": "a", "port": 1}]}`,
+			expectedMatch: true,
+		},
+		"array merge keys - semantically equal - identities compared by numeric value": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys:        map[string]string{"/rules": "id"},
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `{"rules": [{"id": 1, "action": "allow"}, {"id": 2, "action": "deny"}]}`,
+			givenJson:     `{"rules": [{"id": 2.0, "action": "deny"}, {"id": 1e0, "action": "allow"}]}`,
+			expectedMatch: true,
+		},
+		"array merge keys - semantically equal - identities coerced from strings": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys:        map[string]string{"/rules": "id"},
+				CoerceScalars:         true,
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `{"rules": [{"id": 1, "action": "allow"}, {"id": 2, "action": "deny"}]}`,
+			givenJson:     `{"rules": [{"id": "2", "action": "deny"}, {"id": "1.0", "action": "allow"}]}`,
+			expectedMatch: true,
+		},
+		"array merge keys - not equal - identities with different numeric representation": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys: map[string]string{"/rules": "id"},
+			},
+			currentJson:   `{"rules": [{"id": 1, "action": "allow"}, {"id": 2, "action": "deny"}]}`,
+			givenJson:     `{"rules": [{"id": 2.0, "action": "deny"}, {"id": 1.0, "action": "allow"}]}`,
+			expectedMatch: false,
+		},
+		"array merge keys - error - invalid pointer": {
+			options: jsontypes.NormalizedOptions{
+				ArrayMergeKeys: map[string]string{"containers": "name"},
+			},
+			currentJson:   `{"containers": []}`,
+			givenJson:     `{"containers": []}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid ArrayMergeKeys: invalid JSON Pointer \"containers\": must be empty or begin with \"/\"",
+				),
+			},
+		},
+		"number tolerance - semantically equal - within absolute tolerance": {
+			options: jsontypes.NormalizedOptions{
+				NumberTolerance: jsontypes.NumberTolerance{Absolute: 1e-6},
+			},
+			currentJson:   `{"threshold": 0.1, "values": [2.5, 1e-7]}`,
+			givenJson:     `{"threshold": 0.09999999, "values": [2.5000001, 0]}`,
+			expectedMatch: true,
+		},
+		"number tolerance - semantically equal - within relative tolerance": {
+			options: jsontypes.NormalizedOptions{
+				NumberTolerance: jsontypes.NumberTolerance{Relative: 1e-6},
+			},
+			currentJson:   `{"rate": 1234567.8}`,
+			givenJson:     `{"rate": 1234567.9}`,
+			expectedMatch: true,
+		},
+		"number tolerance - not equal - outside tolerance": {
+			options: jsontypes.NormalizedOptions{
+				NumberTolerance: jsontypes.NumberTolerance{Absolute: 1e-6, Relative: 1e-9},
+			},
+			currentJson:   `{"threshold": 0.1}`,
+			givenJson:     `{"threshold": 0.11}`,
+			expectedMatch: false,
+		},
+		"number tolerance - not equal - integers are compared exactly": {
+			options: jsontypes.NormalizedOptions{
+				NumberTolerance: jsontypes.NumberTolerance{Relative: 1e-6},
+			},
+			currentJson:   `{"id": 1234567890123}`,
+			givenJson:     `{"id": 1234567890124}`,
+			expectedMatch: false,
+		},
+		"number tolerance - semantically equal - configured path": {
+			options: jsontypes.NormalizedOptions{
+				NumberTolerance: jsontypes.NumberTolerance{Absolute: 1e-6, Paths: []string{"/alarms/*/threshold"}},
+			},
+			currentJson:   `{"alarms": [{"threshold": 0.1, "period": 60}]}`,
+			givenJson:     `{"alarms": [{"threshold": 0.09999999, "period": 60}]}`,
+			expectedMatch: true,
+		},
+		"number tolerance - not equal - unconfigured path": {
+			options: jsontypes.NormalizedOptions{
+				NumberTolerance: jsontypes.NumberTolerance{Absolute: 1e-6, Paths: []string{"/alarms/*/threshold"}},
+			},
+			currentJson:   `{"alarms": [{"threshold": 0.1, "ratio": 0.5}]}`,
+			givenJson:     `{"alarms": [{"threshold": 0.1, "ratio": 0.49999999}]}`,
+			expectedMatch: false,
+		},
+		"number tolerance - semantically equal - unordered arrays": {
+			options: jsontypes.NormalizedOptions{
+				NumberTolerance: jsontypes.NumberTolerance{Absolute: 0.15},
+				UnorderedArrays: true,
+			},
+			currentJson:   `[1.0, 1.2]`,
+			givenJson:     `[1.1, 1.3]`,
+			expectedMatch: true,
+		},
+		"number tolerance - error - negative tolerance": {
+			options: jsontypes.NormalizedOptions{
+				NumberTolerance: jsontypes.NumberTolerance{Absolute: -1},
+			},
+			currentJson:   `{"threshold": 0.1}`,
+			givenJson:     `{"threshold": 0.1}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid NumberTolerance: tolerances must not be negative",
+				),
+			},
+		},
+		"comparators - semantically equal - comparator reports equal": {
+			options: jsontypes.NormalizedOptions{
+				Comparators: map[string]jsontypes.Comparator{"/items/*/state": caseInsensitiveComparator{}},
+			},
+			currentJson:   `{"items": [{"state": "ENABLED", "name": "a"}]}`,
+			givenJson:     `{"items": [{"state": "enabled", "name": "a"}]}`,
+			expectedMatch: true,
+		},
+		"comparators - not equal - comparator reports not equal": {
+			options: jsontypes.NormalizedOptions{
+				Comparators: map[string]jsontypes.Comparator{"/state": caseInsensitiveComparator{}},
+			},
+			currentJson:   `{"state": "ENABLED"}`,
+			givenJson:     `{"state": "disabled"}`,
+			expectedMatch: false,
+		},
+		"comparators - not equal - other values are compared with default logic": {
+			options: jsontypes.NormalizedOptions{
+				Comparators: map[string]jsontypes.Comparator{"/state": caseInsensitiveComparator{}},
+			},
+			currentJson:   `{"state": "ENABLED", "name": "A"}`,
+			givenJson:     `{"state": "enabled", "name": "a"}`,
+			expectedMatch: false,
+		},
+		"comparators - not equal - comparator diagnostics": {
+			options: jsontypes.NormalizedOptions{
+				Comparators: map[string]jsontypes.Comparator{"/state": caseInsensitiveComparator{}},
+			},
+			currentJson:   `{"state": 1}`,
+			givenJson:     `{"state": 1}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Value Type",
+					"Expected string values at /state, got json.Number and json.Number",
+				),
+			},
+		},
+		"comparators - semantically equal - diagnostics of unpaired elements are discarded": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrays: true,
+				Comparators:     map[string]jsontypes.Comparator{"/0": caseInsensitiveComparator{}},
+			},
+			currentJson:   `["a", {"x": 1}]`,
+			givenJson:     `[{"x": 1}, "A"]`,
+			expectedMatch: true,
+		},
+		"comparators - not equal - diagnostics of unpaired elements are kept when no pairing exists": {
+			options: jsontypes.NormalizedOptions{
+				UnorderedArrays: true,
+				Comparators:     map[string]jsontypes.Comparator{"/0": caseInsensitiveComparator{}},
+			},
+			currentJson:   `["a", {"x": 1}]`,
+			givenJson:     `[{"x": 1}, "B"]`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Value Type",
+					"Expected string values at /0, got string and map[string]interface {}",
+				),
+			},
+		},
+		"comparators - error - nil comparator": {
+			options: jsontypes.NormalizedOptions{
+				Comparators: map[string]jsontypes.Comparator{"/state": nil},
+			},
+			currentJson:   `{"state": "ENABLED"}`,
+			givenJson:     `{"state": "ENABLED"}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid Comparators: nil Comparator for \"/state\"",
+				),
+			},
+		},
+		"coerce scalars - semantically equal - stringly-typed numbers and booleans": {
+			options: jsontypes.NormalizedOptions{
+				CoerceScalars: true,
+			},
+			currentJson:   `{"replicas": 3, "enabled": true, "ratio": "0.5", "public": "false"}`,
+			givenJson:     `{"replicas": "3", "enabled": "true", "ratio": 0.5, "public": false}`,
+			expectedMatch: true,
+		},
+		"coerce scalars - semantically equal - with compare numbers by value": {
+			options: jsontypes.NormalizedOptions{
+				CoerceScalars:         true,
+				CompareNumbersByValue: true,
+			},
+			currentJson:   `{"replicas": 3}`,
+			givenJson:     `{"replicas": "3.0"}`,
+			expectedMatch: true,
+		},
+		"coerce scalars - not equal - different number representation": {
+			options: jsontypes.NormalizedOptions{
+				CoerceScalars: true,
+			},
+			currentJson:   `{"replicas": 3}`,
+			givenJson:     `{"replicas": "3.0"}`,
+			expectedMatch: false,
+		},
+		"coerce scalars - not equal - string is not a JSON number": {
+			options: jsontypes.NormalizedOptions{
+				CoerceScalars: true,
+			},
+			currentJson:   `{"replicas": 3, "port": 80}`,
+			givenJson:     `{"replicas": " 3", "port": "0x50"}`,
+			expectedMatch: false,
+		},
+		"coerce scalars - not equal - boolean strings are case-sensitive": {
+			options: jsontypes.NormalizedOptions{
+				CoerceScalars: true,
+			},
+			currentJson:   `{"enabled": true}`,
+			givenJson:     `{"enabled": "True"}`,
+			expectedMatch: false,
+		},
+		"coerce scalars - not equal - null is not coerced": {
+			options: jsontypes.NormalizedOptions{
+				CoerceScalars: true,
+			},
+			currentJson:   `{"enabled": null}`,
+			givenJson:     `{"enabled": "null"}`,
+			expectedMatch: false,
+		},
+		"coerce scalars - not equal - disabled": {
+			options:       jsontypes.NormalizedOptions{},
+			currentJson:   `{"replicas": 3}`,
+			givenJson:     `{"replicas": "3"}`,
+			expectedMatch: false,
+		},
+		"empty arrays as null - semantically equal - empty array, null and absent member": {
+			options: jsontypes.NormalizedOptions{
+				EmptyArraysAsNull: true,
+			},
+			currentJson:   `{"name": "example", "tags": [], "rules": null, "nested": {"items": []}}`,
+			givenJson:     `{"name": "example", "rules": [], "nested": {"items": null}}`,
+			expectedMatch: true,
+		},
+		"empty arrays as null - semantically equal - root": {
+			options: jsontypes.NormalizedOptions{
+				EmptyArraysAsNull: true,
+			},
+			currentJson:   `[]`,
+			givenJson:     `null`,
+			expectedMatch: true,
+		},
+		"empty arrays as null - not equal - empty object": {
+			options: jsontypes.NormalizedOptions{
+				EmptyArraysAsNull: true,
+			},
+			currentJson:   `{"name": "example", "settings": {}}`,
+			givenJson:     `{"name": "example"}`,
+			expectedMatch: false,
+		},
+		"empty arrays as null - not equal - non-empty array": {
+			options: jsontypes.NormalizedOptions{
+				EmptyArraysAsNull: true,
+			},
+			currentJson:   `{"tags": [null]}`,
+			givenJson:     `{}`,
+			expectedMatch: false,
+		},
+		"empty objects as null - semantically equal - empty object, null and absent member": {
+			options: jsontypes.NormalizedOptions{
+				EmptyObjectsAsNull: true,
+			},
+			currentJson:   `{"name": "example", "labels": {}, "annotations": null}`,
+			givenJson:     `{"name": "example", "annotations": {}}`,
+			expectedMatch: true,
+		},
+		"empty objects as null - semantically equal - objects which become empty": {
+			options: jsontypes.NormalizedOptions{
+				EmptyObjectsAsNull: true,
+			},
+			currentJson:   `{"name": "example", "settings": {"nested": {"unset": null}}}`,
+			givenJson:     `{"name": "example"}`,
+			expectedMatch: true,
+		},
+		"empty objects as null - not equal - empty array": {
+			options: jsontypes.NormalizedOptions{
+				EmptyObjectsAsNull: true,
+			},
+			currentJson:   `{"name": "example", "tags": []}`,
+			givenJson:     `{"name": "example"}`,
+			expectedMatch: false,
+		},
+		"empty arrays and objects as null - semantically equal - nested empty containers": {
+			options: jsontypes.NormalizedOptions{
+				EmptyArraysAsNull:  true,
+				EmptyObjectsAsNull: true,
+			},
+			currentJson:   `{"name": "example", "spec": {"volumes": [], "labels": {}}}`,
+			givenJson:     `{"name": "example", "spec": null}`,
+			expectedMatch: true,
+		},
+		"embedded json - semantically equal - whitespace and property order": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSON: true,
+			},
+			currentJson:   `{"name": "example", "input": "{\"b\": [1, 2], \"a\": {\"c\": true}}"}`,
+			givenJson:     `{"name": "example", "input": "{\"a\":{\"c\":true},\"b\":[1,2]}"}`,
+			expectedMatch: true,
+		},
+		"embedded json - semantically equal - nested embedded json": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSON: true,
+			},
+			currentJson:   `{"input": "{\"policy\": \"[1, 2]\"}"}`,
+			givenJson:     `{"input": "{\"policy\":\"[1,2]\"}"}`,
+			expectedMatch: true,
+		},
+		"embedded json - semantically equal - embedded json paths": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSONPaths: []string{"/targets/*/input"},
+			},
+			currentJson:   `{"targets": [{"input": "{\"b\": 1, \"a\": 2}"}]}`,
+			givenJson:     `{"targets": [{"input": "{\"a\":2,\"b\":1}"}]}`,
+			expectedMatch: true,
+		},
+		"embedded json - semantically equal - options apply within embedded json": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSONPaths: []string{"/input"},
+				IgnorePaths:       []string{"/input/etag"},
+			},
+			currentJson:   `{"input": "{\"etag\": \"1\", \"a\": 1}"}`,
+			givenJson:     `{"input": "{\"a\":1,\"etag\":\"2\"}"}`,
+			expectedMatch: true,
+		},
+		"embedded json - not equal - option not configured": {
+			options: jsontypes.NormalizedOptions{
+				IgnoreNullMembers: true,
+			},
+			currentJson:   `{"input": "{\"b\": 1, \"a\": 2}"}`,
+			givenJson:     `{"input": "{\"a\":2,\"b\":1}"}`,
+			expectedMatch: false,
+		},
+		"embedded json - not equal - unconfigured path": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSONPaths: []string{"/input"},
+			},
+			currentJson:   `{"input": "{}", "output": "{\"b\": 1, \"a\": 2}"}`,
+			givenJson:     `{"input": "{}", "output": "{\"a\":2,\"b\":1}"}`,
+			expectedMatch: false,
+		},
+		"embedded json - not equal - different embedded json": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSON: true,
+			},
+			currentJson:   `{"input": "{\"a\": 1}"}`,
+			givenJson:     `{"input": "{\"a\": 2}"}`,
+			expectedMatch: false,
+		},
+		"embedded json - not equal - embedded scalars compared as strings": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSON: true,
+			},
+			currentJson:   `{"input": "1"}`,
+			givenJson:     `{"input": " 1"}`,
+			expectedMatch: false,
+		},
+		"embedded json - not equal - invalid embedded json": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSON: true,
+			},
+			currentJson:   `{"input": "{\"a\": 1"}`,
+			givenJson:     `{"input": "{\"a\":1"}`,
+			expectedMatch: false,
+		},
+		"embedded json paths - error - invalid pointer": {
+			options: jsontypes.NormalizedOptions{
+				EmbeddedJSONPaths: []string{"input"},
+			},
+			currentJson:   `{"input": "{}"}`,
+			givenJson:     `{"input": "{}"}`,
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid EmbeddedJSONPaths: invalid JSON Pointer \"input\": must be empty or begin with \"/\"",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			typ := jsontypes.NormalizedType{Options: &testCase.options}
+
+			currentJson, diags := typ.ValueFromString(ctx, basetypes.NewStringValue(testCase.currentJson))
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			givenJson, diags := typ.ValueFromString(ctx, basetypes.NewStringValue(testCase.givenJson))
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			normalized, ok := currentJson.(jsontypes.Normalized)
+			if !ok {
+				t.Fatalf("Expected value type jsontypes.Normalized, got %T", currentJson)
+			}
+
+			match, diags := normalized.StringSemanticEquals(ctx, givenJson)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+// caseInsensitiveComparator is a jsontypes.Comparator which compares strings without regard to case.
+type caseInsensitiveComparator struct{}
+
+func (c caseInsensitiveComparator) Compare(_ context.Context, req jsontypes.CompareRequest, resp *jsontypes.CompareResponse) {
+	priorString, priorOk := req.PriorValue.(string)
+	newString, newOk := req.NewValue.(string)
+
+	if !priorOk || !newOk {
+		resp.Diagnostics.AddError(
+			"Unexpected Value Type",
+			fmt.Sprintf("Expected string values at %s, got %T and %T", req.Path, req.PriorValue, req.NewValue),
+		)
+
+		return
+	}
+
+	resp.Equal = strings.EqualFold(priorString, newString)
+}
+
+// allowedValuesComparator is a jsontypes.Comparator with an interface field, so whether its values are comparable with
+// the == operator depends on the value held by that field.
+type allowedValuesComparator struct {
+	Allowed any
+}
+
+func (c allowedValuesComparator) Compare(ctx context.Context, req jsontypes.CompareRequest, resp *jsontypes.CompareResponse) {
+	caseInsensitiveComparator{}.Compare(ctx, req, resp)
+}
+
+// comparatorFunc is a jsontypes.Comparator adapter for ordinary functions.
+type comparatorFunc func(ctx context.Context, req jsontypes.CompareRequest, resp *jsontypes.CompareResponse)
+
+func (f comparatorFunc) Compare(ctx context.Context, req jsontypes.CompareRequest, resp *jsontypes.CompareResponse) {
+	f(ctx, req, resp)
+}
+
+// compareEqualFold compares strings without regard to case, for use with comparatorFunc.
+func compareEqualFold(ctx context.Context, req jsontypes.CompareRequest, resp *jsontypes.CompareResponse) {
+	caseInsensitiveComparator{}.Compare(ctx, req, resp)
+}
@@ -20,18 +20,36 @@ var (
 // NormalizedType is an attribute type that represents a valid JSON string (RFC 7159). Semantic equality logic is defined for NormalizedType
 // such that inconsequential differences between JSON strings are ignored (whitespace, property order, etc). If you need strict, byte-for-byte,
 // string equality, consider using ExactType.
+//
+// Options can be set to further configure the semantic equality logic, such as ignoring server-managed fields. Types with
+// different Options are not equal, while a nil Options is equal to a pointer to the zero value. Types with the same
+// Options pointer are always equal.
+//
+// Values created by the NewNormalizedValue and other NewNormalized functions have a NormalizedType without Options. Use
+// the NewValue and other New methods of the type instead for values of a configured type, such as the elements of a
+// collection of that type, as every element must have the element type of the collection.
 type NormalizedType struct {
 	basetypes.StringType
+
+	// Options configures the semantic equality logic of values of this type. A nil pointer keeps the default behavior.
+	// It is a pointer so the type remains comparable with the == operator.
+	Options *NormalizedOptions
 }
 
 // String returns a human readable string of the type name.
 func (t NormalizedType) String() string {
+	if options := t.Options.value().String(); options != "" {
+		return "jsontypes.NormalizedType[" + options + "]"
+	}
+
 	return "jsontypes.NormalizedType"
 }
 
 // ValueType returns the Value type.
 func (t NormalizedType) ValueType(ctx context.Context) attr.Value {
-	return Normalized{}
+	return Normalized{
+		options: t.Options,
+	}
 }
 
 // Equal returns true if the given type is equivalent.
@@ -42,13 +60,19 @@ func (t NormalizedType) Equal(o attr.Type) bool {
 		return false
 	}
 
-	return t.StringType.Equal(other.StringType)
+	if !t.StringType.Equal(other.StringType) {
+		return false
+	}
+
+	// The same options are equal even if they contain Comparators which are not equal to themselves, such as funcs.
+	return t.Options == other.Options || t.Options.value().Equal(other.Options.value())
 }
 
 // ValueFromString returns a StringValuable type given a StringValue.
 func (t NormalizedType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
 	return Normalized{
 		StringValue: in,
+		options:     t.Options,
 	}, nil
 }
 
@@ -72,3 +96,39 @@ func (t NormalizedType) ValueFromTerraform(ctx context.Context, in tftypes.Value
 
 	return stringValuable, nil
 }
+
+// NewNull creates a Normalized with a null value and the Options of the type. Determine whether the value is null via
+// IsNull method.
+func (t NormalizedType) NewNull() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringNull(),
+		options:     t.Options,
+	}
+}
+
+// NewUnknown creates a Normalized with an unknown value and the Options of the type. Determine whether the value is
+// unknown via IsUnknown method.
+func (t NormalizedType) NewUnknown() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringUnknown(),
+		options:     t.Options,
+	}
+}
+
+// NewValue creates a Normalized with a known value and the Options of the type. Access the value via ValueString
+// method.
+func (t NormalizedType) NewValue(value string) Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringValue(value),
+		options:     t.Options,
+	}
+}
+
+// NewPointerValue creates a Normalized with a null value if nil or a known value, and the Options of the type. Access
+// the value via ValueStringPointer method.
+func (t NormalizedType) NewPointerValue(value *string) Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringPointerValue(value),
+		options:     t.Options,
+	}
+}
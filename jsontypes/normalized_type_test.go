@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
@@ -69,3 +70,419 @@ func TestNormalizedTypeValueFromTerraform(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizedTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	comparatorFuncOptions := &jsontypes.NormalizedOptions{
+		Comparators: map[string]jsontypes.Comparator{"/state": comparatorFunc(compareEqualFold)},
+	}
+
+	testCases := map[string]struct {
+		typ      jsontypes.NormalizedType
+		other    attr.Type
+		expected bool
+	}{
+		"equal - no options": {
+			typ:      jsontypes.NormalizedType{},
+			other:    jsontypes.NormalizedType{},
+			expected: true,
+		},
+		"equal - nil and zero value options": {
+			typ: jsontypes.NormalizedType{},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{},
+			},
+			expected: true,
+		},
+		"equal - same ignore paths in different order": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnorePaths: []string{"/etag", "/metadata/createdAt"}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnorePaths: []string{"/metadata/createdAt", "/etag"}},
+			},
+			expected: true,
+		},
+		"not equal - different ignore paths": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnorePaths: []string{"/etag"}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnorePaths: []string{"/metadata/createdAt"}},
+			},
+			expected: false,
+		},
+		"not equal - different unordered arrays": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{UnorderedArrays: true},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{UnorderedArrayPaths: []string{"/tags"}},
+			},
+			expected: false,
+		},
+		"not equal - different ignore null members": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnoreNullMembers: true},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnoreNullMembers: false},
+			},
+			expected: false,
+		},
+		"not equal - different compare numbers by value": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{CompareNumbersByValue: true},
+			},
+			other:    jsontypes.NormalizedType{},
+			expected: false,
+		},
+		"equal - same array merge keys": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{ArrayMergeKeys: map[string]string{"/containers": "name"}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{ArrayMergeKeys: map[string]string{"/containers": "name"}},
+			},
+			expected: true,
+		},
+		"not equal - different array merge keys": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{ArrayMergeKeys: map[string]string{"/containers": "name"}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{ArrayMergeKeys: map[string]string{"/containers": "id"}},
+			},
+			expected: false,
+		},
+		"not equal - different number tolerance": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{NumberTolerance: jsontypes.NumberTolerance{Absolute: 1e-6}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{NumberTolerance: jsontypes.NumberTolerance{Absolute: 1e-3}},
+			},
+			expected: false,
+		},
+		"equal - same comparators": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": caseInsensitiveComparator{}}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": caseInsensitiveComparator{}}},
+			},
+			expected: true,
+		},
+		"equal - same comparators with uncomparable interface fields": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": allowedValuesComparator{Allowed: []string{"x"}}}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": allowedValuesComparator{Allowed: []string{"x"}}}},
+			},
+			expected: true,
+		},
+		"not equal - different comparators with uncomparable interface fields": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": allowedValuesComparator{Allowed: []string{"x"}}}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": allowedValuesComparator{Allowed: []string{"y"}}}},
+			},
+			expected: false,
+		},
+		"not equal - comparators with comparable and uncomparable interface fields": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": allowedValuesComparator{Allowed: "x"}}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": allowedValuesComparator{Allowed: []string{"x"}}}},
+			},
+			expected: false,
+		},
+		"equal - comparator func in same options": {
+			typ: jsontypes.NormalizedType{
+				Options: comparatorFuncOptions,
+			},
+			other: jsontypes.NormalizedType{
+				Options: comparatorFuncOptions,
+			},
+			expected: true,
+		},
+		"not equal - same comparator func in different options": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": comparatorFunc(compareEqualFold)}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": comparatorFunc(compareEqualFold)}},
+			},
+			expected: false,
+		},
+		"not equal - different comparator paths": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": caseInsensitiveComparator{}}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/status": caseInsensitiveComparator{}}},
+			},
+			expected: false,
+		},
+		"not equal - different coerce scalars": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{CoerceScalars: true},
+			},
+			other:    jsontypes.NormalizedType{},
+			expected: false,
+		},
+		"not equal - different empty containers as null": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{EmptyArraysAsNull: true},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{EmptyObjectsAsNull: true},
+			},
+			expected: false,
+		},
+		"not equal - different embedded json paths": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{EmbeddedJSONPaths: []string{"/input"}},
+			},
+			other: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{EmbeddedJSONPaths: []string{"/output"}},
+			},
+			expected: false,
+		},
+		"not equal - options and no options": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnorePaths: []string{"/etag"}},
+			},
+			other:    jsontypes.NormalizedType{},
+			expected: false,
+		},
+		"not equal - different type": {
+			typ:      jsontypes.NormalizedType{},
+			other:    jsontypes.ExactType{},
+			expected: false,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("Expected Equal to return: %t, but got: %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizedTypeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      jsontypes.NormalizedType
+		expected string
+	}{
+		"no options": {
+			typ:      jsontypes.NormalizedType{},
+			expected: "jsontypes.NormalizedType",
+		},
+		"ignore paths": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnorePaths: []string{"/metadata/createdAt", "/etag"}},
+			},
+			expected: `jsontypes.NormalizedType[IgnorePaths: ["/etag" "/metadata/createdAt"]]`,
+		},
+		"unordered arrays": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{UnorderedArrays: true, UnorderedArrayPaths: []string{"/tags"}},
+			},
+			expected: `jsontypes.NormalizedType[UnorderedArrays: true, UnorderedArrayPaths: ["/tags"]]`,
+		},
+		"ignore null members": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{IgnoreNullMembers: true},
+			},
+			expected: `jsontypes.NormalizedType[IgnoreNullMembers: true]`,
+		},
+		"compare numbers by value": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{CompareNumbersByValue: true},
+			},
+			expected: `jsontypes.NormalizedType[CompareNumbersByValue: true]`,
+		},
+		"array merge keys": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{ArrayMergeKeys: map[string]string{"/rules": "id", "/containers": "name"}},
+			},
+			expected: `jsontypes.NormalizedType[ArrayMergeKeys: map["/containers":"name" "/rules":"id"]]`,
+		},
+		"number tolerance": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{NumberTolerance: jsontypes.NumberTolerance{Absolute: 1e-6, Paths: []string{"/threshold"}}},
+			},
+			expected: `jsontypes.NormalizedType[NumberTolerance: {Absolute: 1e-06, Relative: 0, Paths: ["/threshold"]}]`,
+		},
+		"comparators": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{Comparators: map[string]jsontypes.Comparator{"/state": caseInsensitiveComparator{}}},
+			},
+			expected: `jsontypes.NormalizedType[Comparators: map["/state":jsontypes_test.caseInsensitiveComparator]]`,
+		},
+		"coerce scalars": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{CoerceScalars: true},
+			},
+			expected: `jsontypes.NormalizedType[CoerceScalars: true]`,
+		},
+		"empty containers as null": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{EmptyArraysAsNull: true, EmptyObjectsAsNull: true},
+			},
+			expected: `jsontypes.NormalizedType[EmptyArraysAsNull: true, EmptyObjectsAsNull: true]`,
+		},
+		"embedded json": {
+			typ: jsontypes.NormalizedType{
+				Options: &jsontypes.NormalizedOptions{EmbeddedJSON: true, EmbeddedJSONPaths: []string{"/input"}},
+			},
+			expected: `jsontypes.NormalizedType[EmbeddedJSON: true, EmbeddedJSONPaths: ["/input"]]`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.String()
+
+			if got != testCase.expected {
+				t.Errorf("Expected String to return: %q, but got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizedTypeValueFromStringOptions(t *testing.T) {
+	t.Parallel()
+
+	typ := jsontypes.NormalizedType{
+		Options: &jsontypes.NormalizedOptions{IgnorePaths: []string{"/etag"}},
+	}
+
+	got, diags := typ.ValueFromString(context.Background(), basetypes.NewStringValue(`{"etag":"1"}`))
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if !got.Type(context.Background()).Equal(typ) {
+		t.Errorf("Expected value type %s, got %s", typ, got.Type(context.Background()))
+	}
+}
+
+func TestNormalizedTypeComparable(t *testing.T) {
+	t.Parallel()
+
+	options := &jsontypes.NormalizedOptions{
+		IgnorePaths:    []string{"/etag"},
+		ArrayMergeKeys: map[string]string{"/containers": "name"},
+	}
+
+	// Comparing interface values holding non-comparable types panics, so the types must remain comparable when configured.
+	var typ, other attr.Type = jsontypes.NormalizedType{Options: options}, jsontypes.NormalizedType{Options: options}
+
+	if typ != other {
+		t.Errorf("Expected %s to be comparable with the == operator", typ)
+	}
+
+	var value, otherValue attr.Value = typ.ValueType(context.Background()), other.ValueType(context.Background())
+
+	if value != otherValue {
+		t.Errorf("Expected %s values to be comparable with the == operator", typ)
+	}
+}
+
+func TestNormalizedTypeNewValue(t *testing.T) {
+	t.Parallel()
+
+	typ := jsontypes.NormalizedType{
+		Options: &jsontypes.NormalizedOptions{IgnorePaths: []string{"/etag"}},
+	}
+	value := `{"etag":"1"}`
+
+	testCases := map[string]struct {
+		value           jsontypes.Normalized
+		expectedNull    bool
+		expectedUnknown bool
+		expectedValue   *string
+	}{
+		"null": {
+			value:        typ.NewNull(),
+			expectedNull: true,
+		},
+		"unknown": {
+			value:           typ.NewUnknown(),
+			expectedUnknown: true,
+		},
+		"value": {
+			value:         typ.NewValue(value),
+			expectedValue: &value,
+		},
+		"pointer value": {
+			value:         typ.NewPointerValue(&value),
+			expectedValue: &value,
+		},
+		"pointer value - nil": {
+			value:        typ.NewPointerValue(nil),
+			expectedNull: true,
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.value.Type(context.Background()); !got.Equal(typ) {
+				t.Errorf("Expected value type %s, got %s", typ, got)
+			}
+
+			if got := testCase.value.IsNull(); got != testCase.expectedNull {
+				t.Errorf("Expected IsNull %t, got %t", testCase.expectedNull, got)
+			}
+
+			if got := testCase.value.IsUnknown(); got != testCase.expectedUnknown {
+				t.Errorf("Expected IsUnknown %t, got %t", testCase.expectedUnknown, got)
+			}
+
+			var expectedString string
+			if testCase.expectedValue != nil {
+				expectedString = *testCase.expectedValue
+			}
+
+			if got := testCase.value.ValueString(); got != expectedString {
+				t.Errorf("Expected ValueString %q, got %q", expectedString, got)
+			}
+
+			// The pointer to an unknown value is not meaningful, so it is only checked for null and known values.
+			if !testCase.expectedUnknown {
+				got := testCase.value.ValueStringPointer()
+
+				switch {
+				case testCase.expectedValue == nil && got != nil:
+					t.Errorf("Expected nil ValueStringPointer, got %q", *got)
+				case testCase.expectedValue != nil && got == nil:
+					t.Errorf("Expected ValueStringPointer %q, got nil", *testCase.expectedValue)
+				case testCase.expectedValue != nil && *got != *testCase.expectedValue:
+					t.Errorf("Expected ValueStringPointer %q, got %q", *testCase.expectedValue, *got)
+				}
+			}
+
+			if _, diags := basetypes.NewListValue(typ, []attr.Value{testCase.value}); diags.HasError() {
+				t.Errorf("Unexpected diagnostics creating a list of the type: %v", diags)
+			}
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
@@ -28,11 +27,16 @@ var (
 // need strict, byte-for-byte, string equality, consider using ExactType.
 type Normalized struct {
 	basetypes.StringValue
+
+	// options is the semantic equality configuration of the NormalizedType that created this value.
+	options *NormalizedOptions
 }
 
 // Type returns a NormalizedType.
 func (v Normalized) Type(_ context.Context) attr.Type {
-	return NormalizedType{}
+	return NormalizedType{
+		Options: v.options,
+	}
 }
 
 // Equal returns true if the given value is equivalent.
@@ -49,7 +53,9 @@ func (v Normalized) Equal(o attr.Value) bool {
 // StringSemanticEquals returns true if the given JSON string value is semantically equal to the current JSON string value. When compared,
 // these JSON string values are "normalized" by marshalling them to empty Go structs. This prevents Terraform data consistency errors and
 // resource drift due to inconsequential differences in the JSON strings (whitespace, property order, etc).
-func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+//
+// Any NormalizedOptions of the NormalizedType that created the current value are applied during the comparison.
+func (v Normalized) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	newValue, ok := newValuable.(Normalized)
@@ -65,8 +71,7 @@ func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetype
 		return false, diags
 	}
 
-	result, err := jsonEqual(newValue.ValueString(), v.ValueString())
-
+	rules, err := v.options.value().rules()
 	if err != nil {
 		diags.AddError(
 			"Semantic Equality Check Error",
@@ -78,42 +83,22 @@ func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetype
 		return false, diags
 	}
 
-	return result, diags
-}
-
-func jsonEqual(s1, s2 string) (bool, error) {
-	s1, err := normalizeJSONString(s1)
-	if err != nil {
-		return false, err
-	}
+	result, comparatorDiags, err := rules.jsonEqual(ctx, v.ValueString(), newValue.ValueString())
 
-	s2, err = normalizeJSONString(s2)
 	if err != nil {
-		return false, err
-	}
-
-	return s1 == s2, nil
-}
-
-func normalizeJSONString(jsonStr string) (string, error) {
-	dec := json.NewDecoder(strings.NewReader(jsonStr))
-
-	// This ensures the JSON decoder will not parse JSON numbers into Go's float64 type; avoiding Go
-	// normalizing the JSON number representation or imposing limits on numeric range. See the unit test cases
-	// of StringSemanticEquals for examples.
-	dec.UseNumber()
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
 
-	var temp interface{}
-	if err := dec.Decode(&temp); err != nil {
-		return "", err
+		return false, diags
 	}
 
-	jsonBytes, err := json.Marshal(&temp)
-	if err != nil {
-		return "", err
-	}
+	diags.Append(comparatorDiags...)
 
-	return string(jsonBytes), nil
+	return result, diags
 }
 
 // ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
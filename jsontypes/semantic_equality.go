@@ -0,0 +1,553 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/internal/jsonnumber"
+)
+
+// equalityRules is the compiled form of NormalizedOptions, used to determine whether two JSON strings are semantically equal.
+type equalityRules struct {
+	ignorePaths           []jsonPointer
+	unorderedArrays       bool
+	unorderedArrayPaths   []jsonPointer
+	ignoreNullMembers     bool
+	compareNumbersByValue bool
+	arrayMergeKeys        []arrayMergeKey
+	absoluteTolerance     float64
+	relativeTolerance     float64
+	numberTolerancePaths  []jsonPointer
+	comparators           []pathComparator
+	coerceScalars         bool
+	emptyArraysAsNull     bool
+	emptyObjectsAsNull    bool
+	embeddedJSON          bool
+	embeddedJSONPaths     []jsonPointer
+
+	// ignoreMembers are the names of object members which are removed at any depth.
+	ignoreMembers []string
+
+	// subset allows the new value to contain object members which are not in the prior value.
+	subset bool
+}
+
+// arrayMergeKey is the identity member used to pair the elements of arrays of objects at a path.
+type arrayMergeKey struct {
+	path   jsonPointer
+	member string
+}
+
+// pathComparator is a provider-defined Comparator registered for a path.
+type pathComparator struct {
+	path       jsonPointer
+	comparator Comparator
+}
+
+// comparison holds the state of a single semantic equality check.
+type comparison struct {
+	*equalityRules
+
+	ctx context.Context
+
+	// diags collects diagnostics returned by provider-defined comparators.
+	diags diag.Diagnostics
+}
+
+// jsonEqual returns true if the prior and new JSON strings are semantically equal according to the rules. Both strings are
+// decoded into Go values and compared structurally, which ignores whitespace and object member order. Diagnostics from
+// provider-defined comparators are returned, while an error is returned if either string is not valid JSON.
+func (r *equalityRules) jsonEqual(ctx context.Context, priorJSON, newJSON string) (bool, diag.Diagnostics, error) {
+	priorValue, err := decodeJSON(priorJSON)
+	if err != nil {
+		return false, nil, err
+	}
+
+	newValue, err := decodeJSON(newJSON)
+	if err != nil {
+		return false, nil, err
+	}
+
+	priorValue, _ = r.prepare(nil, priorValue)
+	newValue, _ = r.prepare(nil, newValue)
+
+	c := &comparison{
+		equalityRules: r,
+		ctx:           ctx,
+	}
+
+	result := c.equal(nil, priorValue, newValue)
+
+	return result && !c.diags.HasError(), c.diags, nil
+}
+
+// jsonStringsEqual is jsonEqual for rules without comparators, which are the only source of diagnostics.
+func (r *equalityRules) jsonStringsEqual(ctx context.Context, priorJSON, newJSON string) (bool, error) {
+	result, _, err := r.jsonEqual(ctx, priorJSON, newJSON)
+
+	return result, err
+}
+
+// valuesEqual returns true if the decoded prior and new values are semantically equal according to the rules, for rules
+// without comparators, which are the only source of diagnostics. Unlike jsonEqual, the values are not prepared, so types
+// which normalize values first can prepare them beforehand.
+func (r *equalityRules) valuesEqual(ctx context.Context, priorValue, newValue any) bool {
+	c := &comparison{
+		equalityRules: r,
+		ctx:           ctx,
+	}
+
+	return c.equal(nil, priorValue, newValue)
+}
+
+// decodeJSON decodes the first JSON value in the given string into Go values: map[string]any, []any, json.Number, string,
+// bool or nil.
+func decodeJSON(jsonStr string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(jsonStr))
+
+	// This ensures the JSON decoder will not parse JSON numbers into Go's float64 type; avoiding Go
+	// normalizing the JSON number representation or imposing limits on numeric range. See the unit test cases
+	// of StringSemanticEquals for examples.
+	dec.UseNumber()
+
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// decodeValidJSON decodes the JSON string like decodeJSON, after checking that the whole string is valid JSON, as
+// decodeJSON ignores anything after the first value. An invalid string returns the error of (encoding/json).Unmarshal.
+func decodeValidJSON(jsonStr string) (any, error) {
+	var raw json.RawMessage
+
+	if err := json.Unmarshal([]byte(jsonStr), &raw); err != nil {
+		return nil, err
+	}
+
+	return decodeJSON(jsonStr)
+}
+
+// prepare returns a copy of the decoded value at the given location with ignored paths and members and, if configured,
+// null object members removed and empty containers replaced with null. The boolean result is false if the value itself
+// should be removed.
+func (r *equalityRules) prepare(location []string, value any) (any, bool) {
+	if matchesAny(r.ignorePaths, location) {
+		return nil, false
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		prepared := make(map[string]any, len(value))
+
+		for name, member := range value {
+			if slices.Contains(r.ignoreMembers, name) {
+				continue
+			}
+
+			member, ok := r.prepare(childLocation(location, name), member)
+			if !ok || (member == nil && r.ignoreNullMembers) {
+				continue
+			}
+
+			prepared[name] = member
+		}
+
+		if len(prepared) == 0 && r.emptyObjectsAsNull {
+			return nil, true
+		}
+
+		return prepared, true
+	case []any:
+		prepared := make([]any, 0, len(value))
+
+		for i, element := range value {
+			if element, ok := r.prepare(indexLocation(location, i), element); ok {
+				prepared = append(prepared, element)
+			}
+		}
+
+		if len(prepared) == 0 && r.emptyArraysAsNull {
+			return nil, true
+		}
+
+		return prepared, true
+	default:
+		return value, true
+	}
+}
+
+// equal returns true if the prepared prior and new values at the given location are semantically equal.
+func (c *comparison) equal(location []string, priorValue, newValue any) bool {
+	for _, pathComparator := range c.comparators {
+		if !pathComparator.path.matches(location) {
+			continue
+		}
+
+		req := CompareRequest{
+			Path:       formatJSONPointer(location),
+			PriorValue: priorValue,
+			NewValue:   newValue,
+		}
+		resp := CompareResponse{}
+
+		pathComparator.comparator.Compare(c.ctx, req, &resp)
+
+		c.diags.Append(resp.Diagnostics...)
+
+		return resp.Equal && !resp.Diagnostics.HasError()
+	}
+
+	if c.coerceScalars {
+		if equal, ok := c.coercedScalarsEqual(location, priorValue, newValue); ok {
+			return equal
+		}
+	}
+
+	switch priorValue := priorValue.(type) {
+	case map[string]any:
+		newValue, ok := newValue.(map[string]any)
+		if !ok || (len(priorValue) != len(newValue) && !c.subset) {
+			return false
+		}
+
+		for name, priorMember := range priorValue {
+			newMember, ok := newValue[name]
+			if !ok || !c.equal(childLocation(location, name), priorMember, newMember) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		newValue, ok := newValue.([]any)
+		if !ok || len(priorValue) != len(newValue) {
+			return false
+		}
+
+		if equal, ok := c.keyedElementsEqual(location, priorValue, newValue); ok {
+			return equal
+		}
+
+		if c.unorderedArrays || matchesAny(c.unorderedArrayPaths, location) {
+			return c.elementsMatch(location, priorValue, newValue)
+		}
+
+		for i := range priorValue {
+			if !c.equal(indexLocation(location, i), priorValue[i], newValue[i]) {
+				return false
+			}
+		}
+
+		return true
+	case json.Number:
+		newValue, ok := newValue.(json.Number)
+		if !ok {
+			return false
+		}
+
+		return c.numbersEqual(location, priorValue.String(), newValue.String())
+	case string:
+		newValue, ok := newValue.(string)
+		if !ok {
+			return false
+		}
+
+		if priorValue == newValue {
+			return true
+		}
+
+		if c.embeddedJSON || matchesAny(c.embeddedJSONPaths, location) {
+			return c.embeddedJSONEqual(location, priorValue, newValue)
+		}
+
+		return false
+	case bool:
+		newValue, ok := newValue.(bool)
+
+		return ok && priorValue == newValue
+	default:
+		return priorValue == nil && newValue == nil
+	}
+}
+
+// keyedElementsEqual pairs the elements of the prior and new arrays by the value of their identity member, if a merge key
+// is configured for the location, and returns true if every pair is semantically equal. The boolean result is false if
+// the elements cannot be paired by identity, in which case the arrays should be compared without the merge key.
+func (c *comparison) keyedElementsEqual(location []string, priorElements, newElements []any) (bool, bool) {
+	for _, mergeKey := range c.arrayMergeKeys {
+		if !mergeKey.path.matches(location) {
+			continue
+		}
+
+		priorIndexes, ok := c.elementIdentities(priorElements, mergeKey.member)
+		if !ok {
+			return false, false
+		}
+
+		newIndexes, ok := c.elementIdentities(newElements, mergeKey.member)
+		if !ok {
+			return false, false
+		}
+
+		if len(priorIndexes) != len(newIndexes) {
+			return false, true
+		}
+
+		for identity, i := range priorIndexes {
+			j, ok := newIndexes[identity]
+			if !ok || !c.equal(indexLocation(location, i), priorElements[i], newElements[j]) {
+				return false, true
+			}
+		}
+
+		return true, true
+	}
+
+	return false, false
+}
+
+// elementIdentities returns the index of each array element keyed by the JSON encoding of its identity member, after the
+// identity is normalized by identityValue. The boolean result is false if any element is not an object containing the
+// member, or if identities are not unique.
+func (c *comparison) elementIdentities(elements []any, member string) (map[string]int, bool) {
+	indexes := make(map[string]int, len(elements))
+
+	for i, element := range elements {
+		object, ok := element.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		identity, ok := object[member]
+		if !ok {
+			return nil, false
+		}
+
+		identityBytes, err := json.Marshal(c.identityValue(identity))
+		if err != nil {
+			return nil, false
+		}
+
+		if _, ok := indexes[string(identityBytes)]; ok {
+			return nil, false
+		}
+
+		indexes[string(identityBytes)] = i
+	}
+
+	return indexes, true
+}
+
+// identityValue returns the scalar identity with the same representation as every other identity it would be compared
+// equal to by CoerceScalars and CompareNumbersByValue, so that such identities are paired. For example, with both enabled,
+// 1, 1.0 and "1" are all represented as the number 1. Other identities, and NumberTolerance, are not normalized, so those
+// identities must match exactly.
+func (c *comparison) identityValue(identity any) any {
+	if s, ok := identity.(string); ok && c.coerceScalars {
+		switch {
+		case jsonnumber.Valid(s):
+			identity = json.Number(s)
+		case s == "true" || s == "false":
+			identity = s == "true"
+		}
+	}
+
+	if number, ok := identity.(json.Number); ok && c.compareNumbersByValue {
+		// Decoded json.Number values and coerced strings are always valid JSON numbers, so an error here is not expected.
+		if decimal, err := jsonnumber.ParseDecimal(number.String()); err == nil {
+			return json.Number(decimal.String())
+		}
+	}
+
+	return identity
+}
+
+// embeddedJSONEqual returns true if the prior and new strings at the given location both contain a JSON object or array,
+// and the embedded documents are semantically equal. The embedded documents are prepared and compared at the location of
+// the strings, so paths within them continue from the location.
+func (c *comparison) embeddedJSONEqual(location []string, priorString, newString string) bool {
+	priorDocument, ok := decodeEmbeddedJSON(priorString)
+	if !ok {
+		return false
+	}
+
+	newDocument, ok := decodeEmbeddedJSON(newString)
+	if !ok {
+		return false
+	}
+
+	priorDocument, _ = c.prepare(location, priorDocument)
+	newDocument, _ = c.prepare(location, newDocument)
+
+	return c.equal(location, priorDocument, newDocument)
+}
+
+// decodeEmbeddedJSON decodes the string if it contains exactly one JSON object or array. The boolean result is false if it
+// does not, such as for strings which only contain a JSON scalar, as those are not considered to be embedded documents.
+func decodeEmbeddedJSON(s string) (any, bool) {
+	trimmed := strings.TrimLeft(s, " \t\n\r")
+
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return nil, false
+	}
+
+	if !json.Valid([]byte(s)) {
+		return nil, false
+	}
+
+	value, err := decodeJSON(s)
+
+	return value, err == nil
+}
+
+// coercedScalarsEqual compares a JSON string with a JSON number or boolean, by interpreting the string as the same kind
+// of scalar. The boolean result is false if the values are not a string and a number or boolean.
+func (c *comparison) coercedScalarsEqual(location []string, priorValue, newValue any) (bool, bool) {
+	switch priorValue := priorValue.(type) {
+	case string:
+		switch newValue := newValue.(type) {
+		case json.Number:
+			return jsonnumber.Valid(priorValue) && c.numbersEqual(location, priorValue, newValue.String()), true
+		case bool:
+			return priorValue == strconv.FormatBool(newValue), true
+		}
+	case json.Number:
+		if newValue, ok := newValue.(string); ok {
+			return jsonnumber.Valid(newValue) && c.numbersEqual(location, priorValue.String(), newValue), true
+		}
+	case bool:
+		if newValue, ok := newValue.(string); ok {
+			return newValue == strconv.FormatBool(priorValue), true
+		}
+	}
+
+	return false, false
+}
+
+// numbersEqual returns true if the prior and new JSON numbers at the given location are semantically equal. By default,
+// numbers must have the same representation, as Go's encoding/json library does not normalize json.Number values.
+func (c *comparison) numbersEqual(location []string, priorNumber, newNumber string) bool {
+	if priorNumber == newNumber {
+		return true
+	}
+
+	if c.compareNumbersByValue {
+		// Decoded json.Number values are always valid JSON numbers, so an error here is not expected.
+		if equal, err := jsonnumber.Equal(priorNumber, newNumber); err == nil && equal {
+			return true
+		}
+	}
+
+	if c.absoluteTolerance == 0 && c.relativeTolerance == 0 {
+		return false
+	}
+
+	if len(c.numberTolerancePaths) > 0 && !matchesAny(c.numberTolerancePaths, location) {
+		return false
+	}
+
+	return numbersWithinTolerance(priorNumber, newNumber, c.absoluteTolerance, c.relativeTolerance)
+}
+
+// elementsMatch returns true if every prior element can be paired with a distinct, semantically equal new element,
+// regardless of order. Elements are paired via augmenting paths (bipartite matching) rather than greedily, as configured
+// rules may make element equality non-transitive. The locations of elements are those of the prior value.
+//
+// Comparator diagnostics are only kept for the pairs of the resulting matching, as other pairs are only compared while
+// searching for it. If no matching exists, the diagnostics of every compared pair are kept to explain the difference.
+func (c *comparison) elementsMatch(location []string, priorElements, newElements []any) bool {
+	if len(priorElements) != len(newElements) {
+		return false
+	}
+
+	// equalElements caches element comparisons, indexed by prior then new element: 0 is unknown, 1 is equal and
+	// 2 is not equal. elementDiags holds the comparator diagnostics of each comparison.
+	equalElements := make([][]int8, len(priorElements))
+	elementDiags := make([][]diag.Diagnostics, len(priorElements))
+
+	for i := range equalElements {
+		equalElements[i] = make([]int8, len(newElements))
+		elementDiags[i] = make([]diag.Diagnostics, len(newElements))
+	}
+
+	elementsEqual := func(i, j int) bool {
+		if equalElements[i][j] == 0 {
+			equalElements[i][j] = 2
+
+			trial := &comparison{
+				equalityRules: c.equalityRules,
+				ctx:           c.ctx,
+			}
+
+			if trial.equal(indexLocation(location, i), priorElements[i], newElements[j]) {
+				equalElements[i][j] = 1
+			}
+
+			elementDiags[i][j] = trial.diags
+		}
+
+		return equalElements[i][j] == 1
+	}
+
+	// pairedPrior is the index of the prior element paired with each new element, or -1 if unpaired.
+	pairedPrior := make([]int, len(newElements))
+
+	for j := range pairedPrior {
+		pairedPrior[j] = -1
+	}
+
+	var pair func(i int, visited []bool) bool
+
+	pair = func(i int, visited []bool) bool {
+		for j := range newElements {
+			if visited[j] || !elementsEqual(i, j) {
+				continue
+			}
+
+			visited[j] = true
+
+			if pairedPrior[j] == -1 || pair(pairedPrior[j], visited) {
+				pairedPrior[j] = i
+
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i := range priorElements {
+		if !pair(i, make([]bool, len(newElements))) {
+			for i := range elementDiags {
+				for j := range elementDiags[i] {
+					c.diags.Append(elementDiags[i][j]...)
+				}
+			}
+
+			return false
+		}
+	}
+
+	pairedNew := make([]int, len(priorElements))
+
+	for j, i := range pairedPrior {
+		pairedNew[i] = j
+	}
+
+	for i, j := range pairedNew {
+		c.diags.Append(elementDiags[i][j]...)
+	}
+
+	return true
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// strictJSONDecoder decodes a syntactically valid JSON text into Go values like decodeJSON, while rejecting the constructs
+// which encoding/json silently accepts: duplicate object member names, which encoding/json resolves by keeping the last
+// member, and strings containing lone surrogates or invalid UTF-8, which encoding/json replaces with U+FFFD.
+type strictJSONDecoder struct {
+	data []byte
+	pos  int
+}
+
+// decodeStrictJSON decodes the JSON string into Go values: map[string]any, []any, json.Number, string, bool or nil. Errors
+// other than syntax errors describe the JSON Pointer (RFC 6901) of the offending value.
+func decodeStrictJSON(jsonStr string) (any, error) {
+	var raw json.RawMessage
+
+	// Syntax is checked by encoding/json first, so the decoder can assume well-formed input.
+	if err := json.Unmarshal([]byte(jsonStr), &raw); err != nil {
+		return nil, err
+	}
+
+	d := &strictJSONDecoder{
+		data: raw,
+	}
+
+	return d.value(nil)
+}
+
+// value decodes the JSON value at the current position, which is at the given location.
+func (d *strictJSONDecoder) value(location []string) (any, error) {
+	d.skipWhitespace()
+
+	switch d.data[d.pos] {
+	case '{':
+		return d.object(location)
+	case '[':
+		return d.array(location)
+	case '"':
+		s, err := d.string()
+		if err != nil {
+			return nil, fmt.Errorf("at %q: %w", formatJSONPointer(location), err)
+		}
+
+		return s, nil
+	case 't':
+		d.pos += len("true")
+
+		return true, nil
+	case 'f':
+		d.pos += len("false")
+
+		return false, nil
+	case 'n':
+		d.pos += len("null")
+
+		return nil, nil
+	default:
+		start := d.pos
+
+		for d.pos < len(d.data) && strings.IndexByte("+-.0123456789Ee", d.data[d.pos]) != -1 {
+			d.pos++
+		}
+
+		return json.Number(d.data[start:d.pos]), nil
+	}
+}
+
+// object decodes the JSON object at the current position, which is at the given location.
+func (d *strictJSONDecoder) object(location []string) (any, error) {
+	object := make(map[string]any)
+
+	d.pos++
+
+	for {
+		d.skipWhitespace()
+
+		switch d.data[d.pos] {
+		case '}':
+			d.pos++
+
+			return object, nil
+		case ',':
+			d.pos++
+
+			continue
+		}
+
+		name, err := d.string()
+		if err != nil {
+			return nil, fmt.Errorf("at %q: %w in object member name", formatJSONPointer(location), err)
+		}
+
+		memberLocation := childLocation(location, name)
+
+		if _, ok := object[name]; ok {
+			return nil, fmt.Errorf("at %q: duplicate object member name %q", formatJSONPointer(memberLocation), name)
+		}
+
+		d.skipWhitespace()
+		d.pos++ // ':'
+
+		member, err := d.value(memberLocation)
+		if err != nil {
+			return nil, err
+		}
+
+		object[name] = member
+	}
+}
+
+// array decodes the JSON array at the current position, which is at the given location.
+func (d *strictJSONDecoder) array(location []string) (any, error) {
+	array := make([]any, 0)
+
+	d.pos++
+
+	for {
+		d.skipWhitespace()
+
+		switch d.data[d.pos] {
+		case ']':
+			d.pos++
+
+			return array, nil
+		case ',':
+			d.pos++
+
+			continue
+		}
+
+		element, err := d.value(indexLocation(location, len(array)))
+		if err != nil {
+			return nil, err
+		}
+
+		array = append(array, element)
+	}
+}
+
+// string decodes the JSON string at the current position.
+func (d *strictJSONDecoder) string() (string, error) {
+	var b strings.Builder
+
+	d.pos++
+
+	for {
+		switch c := d.data[d.pos]; {
+		case c == '"':
+			d.pos++
+
+			return b.String(), nil
+		case c == '\\':
+			escaped := d.data[d.pos+1]
+			d.pos += 2
+
+			switch escaped {
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'u':
+				r, err := d.unicodeEscape()
+				if err != nil {
+					return "", err
+				}
+
+				b.WriteRune(r)
+			default:
+				// The escaped character is '"', '\\' or '/'.
+				b.WriteByte(escaped)
+			}
+		case c < utf8.RuneSelf:
+			b.WriteByte(c)
+			d.pos++
+		default:
+			r, size := utf8.DecodeRune(d.data[d.pos:])
+			if r == utf8.RuneError && size == 1 {
+				return "", fmt.Errorf("invalid UTF-8 byte %#x", c)
+			}
+
+			b.WriteRune(r)
+			d.pos += size
+		}
+	}
+}
+
+// unicodeEscape decodes the four hexadecimal digits of the "\u" escape at the current position, and the low surrogate
+// escape which must follow a high surrogate.
+func (d *strictJSONDecoder) unicodeEscape() (rune, error) {
+	r := d.hex4()
+
+	if !utf16.IsSurrogate(r) {
+		return r, nil
+	}
+
+	if r < 0xDC00 && d.pos+6 <= len(d.data) && d.data[d.pos] == '\\' && d.data[d.pos+1] == 'u' {
+		d.pos += 2
+
+		if low := d.hex4(); low >= 0xDC00 && low <= 0xDFFF {
+			return utf16.DecodeRune(r, low), nil
+		}
+	}
+
+	return 0, errors.New("lone surrogate \\u" + strconv.FormatInt(int64(r), 16))
+}
+
+// hex4 decodes the four hexadecimal digits at the current position.
+func (d *strictJSONDecoder) hex4() rune {
+	// The digits are known to be valid, as syntax is checked before decoding.
+	r, _ := strconv.ParseUint(string(d.data[d.pos:d.pos+4]), 16, 16)
+
+	d.pos += 4
+
+	return rune(r)
+}
+
+// skipWhitespace advances the position past any insignificant whitespace.
+func (d *strictJSONDecoder) skipWhitespace() {
+	for d.pos < len(d.data) && strings.IndexByte(" \t\n\r", d.data[d.pos]) != -1 {
+		d.pos++
+	}
+}
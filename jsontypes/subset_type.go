@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*SubsetType)(nil)
+)
+
+// SubsetType is an attribute type that represents a valid JSON string (RFC 7159). Semantic equality logic is defined for SubsetType
+// such that a prior JSON string is equal to a new JSON string if it is a structural subset of it, which allows an API to add object
+// members that were not configured. Inconsequential differences between JSON strings are also ignored (whitespace, property order, etc).
+// Consider using NormalizedType if the new JSON string must not contain additional object members.
+type SubsetType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t SubsetType) String() string {
+	return "jsontypes.SubsetType"
+}
+
+// ValueType returns the Value type.
+func (t SubsetType) ValueType(ctx context.Context) attr.Value {
+	return Subset{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t SubsetType) Equal(o attr.Type) bool {
+	other, ok := o.(SubsetType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t SubsetType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Subset{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.  This is meant to convert the tftypes.Value into a more convenient Go type
+// for the provider to consume the data with.
+func (t SubsetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*Subset)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Subset)(nil)
+	_ xattr.ValidateableAttribute                = (*Subset)(nil)
+	_ function.ValidateableParameter             = (*Subset)(nil)
+)
+
+// Subset represents a valid JSON string (RFC 7159). Semantic equality logic is defined for Subset such that a prior
+// JSON string is equal to a new JSON string if it is a structural subset of it, which allows an API to add object
+// members that were not configured. Consider using Normalized if the new JSON string must not contain additional
+// object members.
+type Subset struct {
+	basetypes.StringValue
+}
+
+// Type returns a SubsetType.
+func (v Subset) Type(_ context.Context) attr.Type {
+	return SubsetType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Subset) Equal(o attr.Value) bool {
+	other, ok := o.(Subset)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the current JSON string value is a structural subset of the given JSON string value.
+// Every object member of the current value must exist in the given value with a semantically equal value, recursively,
+// while the given value may contain additional object members. Arrays must have the same length, with elements compared
+// in order using the same subset logic. Like Normalized, inconsequential differences in the JSON strings (whitespace,
+// property order, etc) are ignored.
+//
+// The current value is expected to be the prior value, such as one derived from configuration, while the given value is
+// expected to be the new value, such as one returned by an API which may add defaulted object members.
+func (v Subset) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Subset)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	rules := &equalityRules{
+		subset: true,
+	}
+
+	result, comparatorDiags, err := rules.jsonEqual(ctx, v.ValueString(), newValue.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	diags.Append(comparatorDiags...)
+
+	return result, diags
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid JSON format (RFC 7159).
+func (v Subset) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if ok := json.Valid([]byte(v.ValueString())); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid JSON format (RFC 7159).
+func (v Subset) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if ok := json.Valid([]byte(v.ValueString())); !ok {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid JSON String Value: "+
+				"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (encoding/json).Unmarshal with the Subset StringValue and `target` input. A null or unknown value will produce an error diagnostic.
+// See encoding/json docs for more on usage: https://pkg.go.dev/encoding/json#Unmarshal
+func (v Subset) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Subset JSON Unmarshal Error", "json string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Subset JSON Unmarshal Error", "json string value is unknown"))
+		return diags
+	}
+
+	err := json.Unmarshal([]byte(v.ValueString()), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Subset JSON Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewSubsetNull creates a Subset with a null value. Determine whether the value is null via IsNull method.
+func NewSubsetNull() Subset {
+	return Subset{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewSubsetUnknown creates a Subset with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewSubsetUnknown() Subset {
+	return Subset{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewSubsetValue creates a Subset with a known value. Access the value via ValueString method.
+func NewSubsetValue(value string) Subset {
+	return Subset{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewSubsetPointerValue creates a Subset with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewSubsetPointerValue(value *string) Subset {
+	return Subset{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,5 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package yamltypes contains Terraform Plugin Framework Custom Type implementations for YAML formatted strings (YAML 1.2).
+package yamltypes
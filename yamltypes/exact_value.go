@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yamltypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/internal/yamljson"
+)
+
+var (
+	_ basetypes.StringValuable       = (*Exact)(nil)
+	_ xattr.ValidateableAttribute    = (*Exact)(nil)
+	_ function.ValidateableParameter = (*Exact)(nil)
+)
+
+// Exact represents a valid YAML string (YAML 1.2). No semantic equality logic is defined for Exact,
+// so it will follow Terraform's data-consistency rules for strings, which must match byte-for-byte.
+// Consider using Normalized to allow inconsequential differences between YAML strings (whitespace, property order, etc).
+type Exact struct {
+	basetypes.StringValue
+}
+
+// Type returns an ExactType.
+func (v Exact) Type(_ context.Context) attr.Type {
+	return ExactType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Exact) Equal(o attr.Value) bool {
+	other, ok := o.(Exact)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid YAML format (YAML 1.2), which may contain multiple documents.
+func (v Exact) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := yamljson.DecodeDocuments(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid YAML String Value",
+			"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid YAML format (YAML 1.2), which may contain multiple documents.
+func (v Exact) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := yamljson.DecodeDocuments(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid YAML String Value: "+
+				"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (yaml).Unmarshal with the Exact StringValue and `target` input, which decodes the first YAML document. A null or unknown
+// value will produce an error diagnostic.
+// See go.yaml.in/yaml/v3 docs for more on usage: https://pkg.go.dev/go.yaml.in/yaml/v3#Unmarshal
+func (v Exact) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Exact YAML Unmarshal Error", "yaml string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Exact YAML Unmarshal Error", "yaml string value is unknown"))
+		return diags
+	}
+
+	err := unmarshalYAML(v.ValueString(), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Exact YAML Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewExactNull creates an Exact with a null value. Determine whether the value is null via IsNull method.
+func NewExactNull() Exact {
+	return Exact{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewExactUnknown creates an Exact with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewExactUnknown() Exact {
+	return Exact{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewExactValue creates an Exact with a known value. Access the value via ValueString method.
+func NewExactValue(value string) Exact {
+	return Exact{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewExactPointerValue creates an Exact with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewExactPointerValue(value *string) Exact {
+	return Exact{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
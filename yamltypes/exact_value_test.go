@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yamltypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/yamltypes"
+)
+
+func TestExactValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		exact         yamltypes.Exact
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			exact: yamltypes.Exact{},
+		},
+		"null": {
+			exact: yamltypes.NewExactNull(),
+		},
+		"unknown": {
+			exact: yamltypes.NewExactUnknown(),
+		},
+		"valid yaml mapping": {
+			exact: yamltypes.NewExactValue("hello: world\narray:\n  - 1\n  - 2\n"),
+		},
+		"valid yaml multiple documents": {
+			exact: yamltypes.NewExactValue("hello: world\n---\nhello: again\n"),
+		},
+		"valid json object": {
+			exact: yamltypes.NewExactValue(`{"hello":"world", "array": [1, 2, 3]}`),
+		},
+		"valid yaml scalar": {
+			exact: yamltypes.NewExactValue("notjson123"),
+		},
+		"invalid yaml - bracket mismatch": {
+			exact: yamltypes.NewExactValue(`{"hello":"world"`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid YAML String Value",
+					"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+						"Error: yaml: line 1: did not find expected ',' or '}'\n"+
+						"Given Value: {\"hello\":\"world\"\n",
+				),
+			},
+		},
+		"invalid yaml - duplicate key in second document": {
+			exact: yamltypes.NewExactValue("hello: world\n---\nhello: world\nhello: again\n"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid YAML String Value",
+					"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+						"Error: yaml: unmarshal errors:\n  line 4: mapping key \"hello\" already defined at line 3\n"+
+						"Given Value: hello: world\n---\nhello: world\nhello: again\n\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.exact.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExactValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		exact           yamltypes.Exact
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			exact: yamltypes.Exact{},
+		},
+		"null": {
+			exact: yamltypes.NewExactNull(),
+		},
+		"unknown": {
+			exact: yamltypes.NewExactUnknown(),
+		},
+		"valid yaml mapping": {
+			exact: yamltypes.NewExactValue("hello: world\narray:\n  - 1\n  - 2\n"),
+		},
+		"valid json array": {
+			exact: yamltypes.NewExactValue(`["hello", "world"]`),
+		},
+		"invalid yaml - mapping in sequence": {
+			exact: yamltypes.NewExactValue("- a\n  b: 1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid YAML String Value: "+
+					"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+					"Error: yaml: line 2: mapping values are not allowed in this context\n"+
+					"Given Value: - a\n  b: 1\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.exact.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExactUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		yaml          yamltypes.Exact
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"exact value is null ": {
+			yaml: yamltypes.NewExactNull(),
+			target: struct {
+				Hello string `yaml:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Exact YAML Unmarshal Error",
+					"yaml string value is null",
+				),
+			},
+		},
+		"exact value is unknown ": {
+			yaml: yamltypes.NewExactUnknown(),
+			target: struct {
+				Hello string `yaml:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Exact YAML Unmarshal Error",
+					"yaml string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			yaml: yamltypes.NewExactValue("hello: world"),
+			target: struct {
+				Hello string `yaml:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Exact YAML Unmarshal Error",
+					"yaml: Unmarshal(non-pointer struct { Hello string \"yaml:\\\"hello\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			yaml: yamltypes.NewExactValue("hello: world\nnums: [1, 2, 3]\ntest-bool: true\n"),
+			target: &struct {
+				Hello   string `yaml:"hello"`
+				Numbers []int  `yaml:"nums"`
+				Test    bool   `yaml:"test-bool"`
+			}{},
+			output: &struct {
+				Hello   string `yaml:"hello"`
+				Numbers []int  `yaml:"nums"`
+				Test    bool   `yaml:"test-bool"`
+			}{
+				Hello:   "world",
+				Numbers: []int{1, 2, 3},
+				Test:    true,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.yaml.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yamltypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/internal/yamljson"
+)
+
+var (
+	_ basetypes.StringValuable                   = (*Normalized)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Normalized)(nil)
+	_ xattr.ValidateableAttribute                = (*Normalized)(nil)
+	_ function.ValidateableParameter             = (*Normalized)(nil)
+)
+
+// Normalized represents a valid YAML string (YAML 1.2). Semantic equality logic is defined for Normalized
+// such that inconsequential differences between YAML strings are ignored (whitespace, property order, etc). If you
+// need strict, byte-for-byte, string equality, consider using ExactType.
+type Normalized struct {
+	basetypes.StringValue
+}
+
+// Type returns a NormalizedType.
+func (v Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Normalized) Equal(o attr.Value) bool {
+	other, ok := o.(Normalized)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given YAML string value is semantically equal to the current YAML string value. When compared,
+// each document of these YAML string values is decoded into the Go values of its JSON equivalent. This prevents Terraform data consistency
+// errors and resource drift due to inconsequential differences in the YAML strings (whitespace, property order, quoting, flow or block
+// style, etc). As JSON (RFC 7159) is a subset of YAML 1.2, a YAML string is also semantically equal to a JSON string with the same data,
+// such as one produced by the jsonencode function instead of yamlencode. Numbers are compared by their exact decimal value, so 1.0 and 1
+// are equal, while numbers which only differ beyond the precision of a float64 are not.
+func (v Normalized) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Normalized)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	result, err := yamlEqual(newValue.ValueString(), v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return result, diags
+}
+
+func yamlEqual(s1, s2 string) (bool, error) {
+	documents1, err := yamljson.DecodeDocuments(s1)
+	if err != nil {
+		return false, err
+	}
+
+	documents2, err := yamljson.DecodeDocuments(s2)
+	if err != nil {
+		return false, err
+	}
+
+	if len(documents1) != len(documents2) {
+		return false, nil
+	}
+
+	for i := range documents1 {
+		if !yamljson.Equal(documents1[i], documents2[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// ValidateAttribute implements attribute value validation. This type requires the value provided to be a String
+// value that is valid YAML format (YAML 1.2), which may contain multiple documents.
+func (v Normalized) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := yamljson.DecodeDocuments(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid YAML String Value",
+			"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// ValidateParameter implements provider-defined function parameter value validation. This type requires the value
+// provided to be a String value that is valid YAML format (YAML 1.2), which may contain multiple documents.
+func (v Normalized) ValidateParameter(ctx context.Context, req function.ValidateParameterRequest, resp *function.ValidateParameterResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if _, err := yamljson.DecodeDocuments(v.ValueString()); err != nil {
+		resp.Error = function.NewArgumentFuncError(
+			req.Position,
+			"Invalid YAML String Value: "+
+				"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+				"Error: "+err.Error()+"\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+
+		return
+	}
+}
+
+// Unmarshal calls (yaml).Unmarshal with the Normalized StringValue and `target` input, which decodes the first YAML document. A null or unknown
+// value will produce an error diagnostic.
+// See go.yaml.in/yaml/v3 docs for more on usage: https://pkg.go.dev/go.yaml.in/yaml/v3#Unmarshal
+func (v Normalized) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() {
+		diags.Append(diag.NewErrorDiagnostic("Normalized YAML Unmarshal Error", "yaml string value is null"))
+		return diags
+	}
+
+	if v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic("Normalized YAML Unmarshal Error", "yaml string value is unknown"))
+		return diags
+	}
+
+	err := unmarshalYAML(v.ValueString(), target)
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic("Normalized YAML Unmarshal Error", err.Error()))
+	}
+
+	return diags
+}
+
+// NewNormalizedNull creates a Normalized with a null value. Determine whether the value is null via IsNull method.
+func NewNormalizedNull() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewNormalizedUnknown creates a Normalized with an unknown value. Determine whether the value is unknown via IsUnknown method.
+func NewNormalizedUnknown() Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewNormalizedValue creates a Normalized with a known value. Access the value via ValueString method.
+func NewNormalizedValue(value string) Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewNormalizedPointerValue creates a Normalized with a null value if nil or a known value. Access the value via ValueStringPointer method.
+func NewNormalizedPointerValue(value *string) Normalized {
+	return Normalized{
+		StringValue: basetypes.NewStringPointerValue(value),
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yamltypes_test
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/yamltypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type NormalizedResourceModel struct {
+	Yaml yamltypes.Normalized `tfsdk:"yaml"`
+}
+
+type NormalizedYaml struct {
+	Hello   string `yaml:"hello"`
+	Numbers []int  `yaml:"numbers"`
+}
+
+func ExampleNormalized_Unmarshal() {
+	var diags diag.Diagnostics
+
+	// For example purposes, typically the data model would be populated automatically by Plugin Framework via Config, Plan or State.
+	// https://developer.hashicorp.com/terraform/plugin/framework/handling-data/accessing-values
+	data := NormalizedResourceModel{
+		Yaml: yamltypes.NewNormalizedValue("hello: world\nnumbers:\n  - 1\n  - 2\n  - 3\n"),
+	}
+
+	// Check that the YAML data is known and able to be unmarshalled
+	if !data.Yaml.IsNull() && !data.Yaml.IsUnknown() {
+		var yamlStruct NormalizedYaml
+
+		diags.Append(data.Yaml.Unmarshal(&yamlStruct)...)
+		if diags.HasError() {
+			return
+		}
+
+		// Output: {world [1 2 3]}
+		fmt.Printf("%v\n", yamlStruct)
+	}
+}
@@ -0,0 +1,386 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yamltypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/yamltypes"
+)
+
+func TestNormalizedStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentYaml   yamltypes.Normalized
+		givenYaml     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"not equal - mismatched field values": {
+			currentYaml:   yamltypes.NewNormalizedValue("hello: dlrow\nnums: [3, 2, 1]\nnested:\n  test-bool: false\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("hello: world\nnums: [1, 2, 3]\nnested:\n  test-bool: true\n"),
+			expectedMatch: false,
+		},
+		"not equal - object additional field": {
+			currentYaml:   yamltypes.NewNormalizedValue("hello: world\nnew-field: null\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("hello: world\n"),
+			expectedMatch: false,
+		},
+		"not equal - sequence item order difference": {
+			currentYaml:   yamltypes.NewNormalizedValue("- a\n- b\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("- b\n- a\n"),
+			expectedMatch: false,
+		},
+		"not equal - string and number": {
+			currentYaml:   yamltypes.NewNormalizedValue(`port: "80"`),
+			givenYaml:     yamltypes.NewNormalizedValue(`port: 80`),
+			expectedMatch: false,
+		},
+		"not equal - different number of documents": {
+			currentYaml:   yamltypes.NewNormalizedValue("hello: world\n---\nhello: again\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("hello: world\n"),
+			expectedMatch: false,
+		},
+		"not equal - document order difference": {
+			currentYaml:   yamltypes.NewNormalizedValue("hello: world\n---\nhello: again\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("hello: again\n---\nhello: world\n"),
+			expectedMatch: false,
+		},
+		"semantically equal - byte-for-byte match": {
+			currentYaml:   yamltypes.NewNormalizedValue("hello: world\nnums:\n  - 1\n  - 2\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("hello: world\nnums:\n  - 1\n  - 2\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - field order, quoting and style difference": {
+			currentYaml: yamltypes.NewNormalizedValue(`# comment
+hello: world
+nums:
+  - 1
+  - 2
+nested:
+  test-bool: true
+`),
+			givenYaml:     yamltypes.NewNormalizedValue("nested: {test-bool: true}\nnums: [1, 2]\n'hello': \"world\"\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - json equivalent": {
+			currentYaml:   yamltypes.NewNormalizedValue("hello: world\nnums:\n  - 1\n  - 2.5\nnested:\n  test-bool: true\n  empty: null\n"),
+			givenYaml:     yamltypes.NewNormalizedValue(`{"hello": "world", "nums": [1, 2.5], "nested": {"test-bool": true, "empty": null}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - anchors and aliases": {
+			currentYaml:   yamltypes.NewNormalizedValue("defaults: &defaults\n  retries: 3\nservice:\n  <<: *defaults\n  name: api\n"),
+			givenYaml:     yamltypes.NewNormalizedValue(`{"defaults": {"retries": 3}, "service": {"retries": 3, "name": "api"}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - merge keys are overridden by mapping members": {
+			currentYaml:   yamltypes.NewNormalizedValue("base: &base\n  retries: 3\n  timeout: 10\nservice:\n  timeout: 30\n  <<: *base\n"),
+			givenYaml:     yamltypes.NewNormalizedValue(`{"base": {"retries": 3, "timeout": 10}, "service": {"retries": 3, "timeout": 30}}`),
+			expectedMatch: true,
+		},
+		"semantically equal - multiple documents": {
+			currentYaml:   yamltypes.NewNormalizedValue("---\nhello: world\n---\nnums: [1, 2]\n..."),
+			givenYaml:     yamltypes.NewNormalizedValue("hello:   world\n---\nnums:\n- 1\n- 2\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - timestamps are kept as strings": {
+			currentYaml:   yamltypes.NewNormalizedValue(`created: 2001-12-14`),
+			givenYaml:     yamltypes.NewNormalizedValue(`{"created": "2001-12-14"}`),
+			expectedMatch: true,
+		},
+		"semantically equal - number representations": {
+			currentYaml:   yamltypes.NewNormalizedValue("a: 1.0\nb: 1e3\nc: .inf\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("a: 1\nb: 1000\nc: .Inf\n"),
+			expectedMatch: true,
+		},
+		"semantically equal - yaml 1.2 number representations": {
+			currentYaml:   yamltypes.NewNormalizedValue("a: 010\nb: 0o10\nc: 0x1F\nd: +.5\ne: 1.\nf: 1e400\n"),
+			givenYaml:     yamltypes.NewNormalizedValue(`{"a": 10, "b": 8, "c": 31, "d": 0.5, "e": 1, "f": 1e400}`),
+			expectedMatch: true,
+		},
+		"not equal - yaml 1.1 octal integer": {
+			currentYaml:   yamltypes.NewNormalizedValue("a: 010\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("a: 8\n"),
+			expectedMatch: false,
+		},
+		"not equal - yaml 1.1 integer syntax is a string": {
+			currentYaml:   yamltypes.NewNormalizedValue("a: 0b101\nb: 1_000\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("a: 5\nb: 1000\n"),
+			expectedMatch: false,
+		},
+		"not equal - numbers differing beyond float64 precision": {
+			currentYaml:   yamltypes.NewNormalizedValue("a: 0.1000000000000000001\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("a: 0.1\n"),
+			expectedMatch: false,
+		},
+		"not equal - integers differing beyond float64 precision": {
+			currentYaml:   yamltypes.NewNormalizedValue("a: 123456789012345678901234\n"),
+			givenYaml:     yamltypes.NewNormalizedValue("a: 123456789012345678901235\n"),
+			expectedMatch: false,
+		},
+		"error - invalid yaml": {
+			currentYaml:   yamltypes.NewNormalizedValue("hello: world"),
+			givenYaml:     yamltypes.NewNormalizedValue("hello: [world"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: yaml: line 1: did not find expected ',' or ']'",
+				),
+			},
+		},
+		"error - not given normalized yaml value": {
+			currentYaml:   yamltypes.NewNormalizedValue("hello: world"),
+			givenYaml:     basetypes.NewStringValue("hello: world"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: yamltypes.Normalized\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentYaml.StringSemanticEquals(context.Background(), testCase.givenYaml)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		normalized    yamltypes.Normalized
+		expectedDiags diag.Diagnostics
+	}{
+		"empty-struct": {
+			normalized: yamltypes.Normalized{},
+		},
+		"null": {
+			normalized: yamltypes.NewNormalizedNull(),
+		},
+		"unknown": {
+			normalized: yamltypes.NewNormalizedUnknown(),
+		},
+		"valid yaml mapping": {
+			normalized: yamltypes.NewNormalizedValue("hello: world\narray:\n  - 1\n  - 2\n"),
+		},
+		"valid yaml multiple documents": {
+			normalized: yamltypes.NewNormalizedValue("hello: world\n---\nhello: again\n"),
+		},
+		"valid json object": {
+			normalized: yamltypes.NewNormalizedValue(`{"hello":"world", "array": [1, 2, 3]}`),
+		},
+		"valid yaml scalar": {
+			normalized: yamltypes.NewNormalizedValue("notjson123"),
+		},
+		"invalid yaml - bracket mismatch": {
+			normalized: yamltypes.NewNormalizedValue(`{"hello":"world"`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid YAML String Value",
+					"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+						"Error: yaml: line 1: did not find expected ',' or '}'\n"+
+						"Given Value: {\"hello\":\"world\"\n",
+				),
+			},
+		},
+		"invalid yaml - duplicate key in second document": {
+			normalized: yamltypes.NewNormalizedValue("hello: world\n---\nhello: world\nhello: again\n"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid YAML String Value",
+					"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+						"Error: yaml: unmarshal errors:\n  line 4: mapping key \"hello\" already defined at line 3\n"+
+						"Given Value: hello: world\n---\nhello: world\nhello: again\n\n",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := xattr.ValidateAttributeResponse{}
+
+			testCase.normalized.ValidateAttribute(
+				context.Background(),
+				xattr.ValidateAttributeRequest{
+					Path: path.Root("test"),
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedValidateParameter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		normalized      yamltypes.Normalized
+		expectedFuncErr *function.FuncError
+	}{
+		"empty-struct": {
+			normalized: yamltypes.Normalized{},
+		},
+		"null": {
+			normalized: yamltypes.NewNormalizedNull(),
+		},
+		"unknown": {
+			normalized: yamltypes.NewNormalizedUnknown(),
+		},
+		"valid yaml mapping": {
+			normalized: yamltypes.NewNormalizedValue("hello: world\narray:\n  - 1\n  - 2\n"),
+		},
+		"valid json array": {
+			normalized: yamltypes.NewNormalizedValue(`["hello", "world"]`),
+		},
+		"invalid yaml - mapping in sequence": {
+			normalized: yamltypes.NewNormalizedValue("- a\n  b: 1"),
+			expectedFuncErr: function.NewArgumentFuncError(
+				0,
+				"Invalid YAML String Value: "+
+					"A string value was provided that is not valid YAML string format (YAML 1.2).\n\n"+
+					"Error: yaml: line 2: mapping values are not allowed in this context\n"+
+					"Given Value: - a\n  b: 1\n",
+			),
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := function.ValidateParameterResponse{}
+
+			testCase.normalized.ValidateParameter(
+				context.Background(),
+				function.ValidateParameterRequest{
+					Position: 0,
+				},
+				&resp,
+			)
+
+			if diff := cmp.Diff(resp.Error, testCase.expectedFuncErr); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		yaml          yamltypes.Normalized
+		target        any
+		output        any
+		expectedDiags diag.Diagnostics
+	}{
+		"normalized value is null ": {
+			yaml: yamltypes.NewNormalizedNull(),
+			target: struct {
+				Hello string `yaml:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Normalized YAML Unmarshal Error",
+					"yaml string value is null",
+				),
+			},
+		},
+		"normalized value is unknown ": {
+			yaml: yamltypes.NewNormalizedUnknown(),
+			target: struct {
+				Hello string `yaml:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Normalized YAML Unmarshal Error",
+					"yaml string value is unknown",
+				),
+			},
+		},
+		"invalid target - not a pointer ": {
+			yaml: yamltypes.NewNormalizedValue("hello: world"),
+			target: struct {
+				Hello string `yaml:"hello"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Normalized YAML Unmarshal Error",
+					"yaml: Unmarshal(non-pointer struct { Hello string \"yaml:\\\"hello\\\"\" })",
+				),
+			},
+		},
+		"valid target ": {
+			yaml: yamltypes.NewNormalizedValue("hello: world\nnums: [1, 2, 3]\ntest-bool: true\n"),
+			target: &struct {
+				Hello   string `yaml:"hello"`
+				Numbers []int  `yaml:"nums"`
+				Test    bool   `yaml:"test-bool"`
+			}{},
+			output: &struct {
+				Hello   string `yaml:"hello"`
+				Numbers []int  `yaml:"nums"`
+				Test    bool   `yaml:"test-bool"`
+			}{
+				Hello:   "world",
+				Numbers: []int{1, 2, 3},
+				Test:    true,
+			},
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.yaml.Unmarshal(testCase.target)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.target, testCase.output); testCase.output != nil && diff != "" {
+				t.Errorf("Unexpected target (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
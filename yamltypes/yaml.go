@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yamltypes
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// unmarshalYAML calls (yaml).Unmarshal with the YAML string and target, returning an error rather than panicking if the
+// target is not a non-nil pointer, like (encoding/json).Unmarshal.
+func unmarshalYAML(s string, target any) error {
+	targetValue := reflect.ValueOf(target)
+
+	if target == nil {
+		return errors.New("yaml: Unmarshal(nil)")
+	}
+
+	if targetValue.Kind() != reflect.Pointer {
+		return fmt.Errorf("yaml: Unmarshal(non-pointer %s)", targetValue.Type())
+	}
+
+	if targetValue.IsNil() {
+		return fmt.Errorf("yaml: Unmarshal(nil %s)", targetValue.Type())
+	}
+
+	return yaml.Unmarshal([]byte(s), target)
+}